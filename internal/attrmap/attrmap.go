@@ -1,9 +1,11 @@
 package attrmap
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -15,9 +17,14 @@ import (
 type attributeInfo struct {
 	apiAttributeName string
 	tfType           schema.ValueType
+	tfElemType       schema.ValueType
 	tfComputed       bool
 	tfOptional       bool
 	isIAMPolicy      bool
+	isJSON           bool
+	isJSONList       bool
+	isCreateOnly     bool
+	isUpdateOnly     bool
 }
 
 type AttributeMap map[string]attributeInfo
@@ -36,6 +43,10 @@ func New(attrMap map[string]string, schemaMap map[string]*schema.Schema) Attribu
 			attributeInfo.tfComputed = s.Computed
 			attributeInfo.tfOptional = s.Optional
 
+			if v, ok := s.Elem.(*schema.Schema); ok {
+				attributeInfo.tfElemType = v.Type
+			}
+
 			attributeMap[tfAttributeName] = attributeInfo
 		} else {
 			log.Printf("[ERROR] Unknown attribute: %s", tfAttributeName)
@@ -65,6 +76,16 @@ func (m AttributeMap) ApiAttributesToResourceData(apiAttributes map[string]strin
 				if err != nil {
 					return fmt.Errorf("error parsing %s value (%s) into integer: %w", tfAttributeName, v, err)
 				}
+			case schema.TypeList, schema.TypeSet:
+				if attributeInfo.tfElemType != schema.TypeString {
+					return fmt.Errorf("attribute %s is of unsupported element type: %d", tfAttributeName, attributeInfo.tfElemType)
+				}
+
+				tfAttributeValue, err = attributeInfo.listValue(v)
+
+				if err != nil {
+					return fmt.Errorf("error parsing %s value (%s) into list: %w", tfAttributeName, v, err)
+				}
 			case schema.TypeString:
 				tfAttributeValue = v
 
@@ -76,6 +97,11 @@ func (m AttributeMap) ApiAttributesToResourceData(apiAttributes map[string]strin
 					}
 
 					tfAttributeValue = policy
+				} else if attributeInfo.isJSON {
+					// Keep the configured value if it's equivalent to avoid spurious diffs.
+					if old := d.Get(tfAttributeName).(string); old != "" && v != "" && verify.JSONBytesEqual([]byte(old), []byte(v)) {
+						tfAttributeValue = old
+					}
 				}
 			default:
 				return fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -103,6 +129,10 @@ func (m AttributeMap) ResourceDataToApiAttributesCreate(d *schema.ResourceData)
 			continue
 		}
 
+		if attributeInfo.isUpdateOnly {
+			continue
+		}
+
 		var apiAttributeValue string
 		tfOptionalComputed := attributeInfo.tfComputed && attributeInfo.tfOptional
 
@@ -116,17 +146,21 @@ func (m AttributeMap) ResourceDataToApiAttributesCreate(d *schema.ResourceData)
 			if v := v.(int); !tfOptionalComputed || v != 0 {
 				apiAttributeValue = strconv.Itoa(v)
 			}
-		case schema.TypeString:
-			apiAttributeValue = v.(string)
+		case schema.TypeList, schema.TypeSet:
+			var err error
 
-			if attributeInfo.isIAMPolicy && apiAttributeValue != "" {
-				policy, err := structure.NormalizeJsonString(apiAttributeValue)
+			apiAttributeValue, err = attributeInfo.apiListValue(tfAttributeName, v)
 
-				if err != nil {
-					return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", apiAttributeValue, err)
-				}
+			if err != nil {
+				return nil, err
+			}
+		case schema.TypeString:
+			var err error
+
+			apiAttributeValue, err = attributeInfo.apiStringValue(v.(string))
 
-				apiAttributeValue = policy
+			if err != nil {
+				return nil, err
 			}
 		default:
 			return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -151,6 +185,10 @@ func (m AttributeMap) ResourceDataToApiAttributesUpdate(d *schema.ResourceData)
 			continue
 		}
 
+		if attributeInfo.isCreateOnly {
+			continue
+		}
+
 		if d.HasChange(tfAttributeName) {
 			v := d.Get(tfAttributeName)
 
@@ -161,17 +199,21 @@ func (m AttributeMap) ResourceDataToApiAttributesUpdate(d *schema.ResourceData)
 				apiAttributeValue = strconv.FormatBool(v.(bool))
 			case schema.TypeInt:
 				apiAttributeValue = strconv.Itoa(v.(int))
-			case schema.TypeString:
-				apiAttributeValue = v.(string)
+			case schema.TypeList, schema.TypeSet:
+				var err error
 
-				if attributeInfo.isIAMPolicy {
-					policy, err := structure.NormalizeJsonString(apiAttributeValue)
+				apiAttributeValue, err = attributeInfo.apiListValue(tfAttributeName, v)
 
-					if err != nil {
-						return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", apiAttributeValue, err)
-					}
+				if err != nil {
+					return nil, err
+				}
+			case schema.TypeString:
+				var err error
 
-					apiAttributeValue = policy
+				apiAttributeValue, err = attributeInfo.apiStringValue(v.(string))
+
+				if err != nil {
+					return nil, err
 				}
 			default:
 				return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -199,9 +241,128 @@ func (m AttributeMap) ApiAttributeNames() []string {
 // AWS IAM policies get special handling.
 // This method is intended to be chained with other similar helper methods in a builder pattern.
 func (m AttributeMap) WithIAMPolicyAttribute(tfAttributeName string) AttributeMap {
-	if attributeInfo, ok := m[tfAttributeName]; ok {
+	return m.with(tfAttributeName, func(attributeInfo *attributeInfo) {
 		attributeInfo.isIAMPolicy = true
+	})
+}
+
+// WithJSONAttribute marks the specified Terraform attribute as holding a JSON document, e.g. an SQS redrive policy.
+// JSON documents are normalized before being sent to AWS and equivalent documents read from AWS don't cause a diff.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithJSONAttribute(tfAttributeName string) AttributeMap {
+	return m.with(tfAttributeName, func(attributeInfo *attributeInfo) {
+		attributeInfo.isJSON = true
+	})
+}
+
+// WithJSONListAttribute marks the specified Terraform list or set attribute as encoded as a JSON array of strings.
+// By default list and set attributes are encoded as comma-separated strings.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithJSONListAttribute(tfAttributeName string) AttributeMap {
+	return m.with(tfAttributeName, func(attributeInfo *attributeInfo) {
+		attributeInfo.isJSONList = true
+	})
+}
+
+// WithCreateOnlyAttribute marks the specified Terraform attribute as only being specified on resource create.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithCreateOnlyAttribute(tfAttributeName string) AttributeMap {
+	return m.with(tfAttributeName, func(attributeInfo *attributeInfo) {
+		attributeInfo.isCreateOnly = true
+	})
+}
+
+// WithUpdateOnlyAttribute marks the specified Terraform attribute as only being specified on resource update.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithUpdateOnlyAttribute(tfAttributeName string) AttributeMap {
+	return m.with(tfAttributeName, func(attributeInfo *attributeInfo) {
+		attributeInfo.isUpdateOnly = true
+	})
+}
+
+func (m AttributeMap) with(tfAttributeName string, f func(*attributeInfo)) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		f(&attributeInfo)
+		m[tfAttributeName] = attributeInfo
+	} else {
+		log.Printf("[ERROR] Unknown attribute: %s", tfAttributeName)
 	}
 
 	return m
 }
+
+// apiStringValue returns the AWS API attribute value for a Terraform string value.
+func (attributeInfo attributeInfo) apiStringValue(v string) (string, error) {
+	if v == "" || !(attributeInfo.isIAMPolicy || attributeInfo.isJSON) {
+		return v, nil
+	}
+
+	normalized, err := structure.NormalizeJsonString(v)
+
+	if err != nil {
+		if attributeInfo.isIAMPolicy {
+			return "", fmt.Errorf("policy (%s) is invalid JSON: %w", v, err)
+		}
+
+		return "", fmt.Errorf("value (%s) is invalid JSON: %w", v, err)
+	}
+
+	return normalized, nil
+}
+
+// apiListValue returns the AWS API attribute value for a Terraform list or set of strings value.
+func (attributeInfo attributeInfo) apiListValue(tfAttributeName string, v interface{}) (string, error) {
+	if attributeInfo.tfElemType != schema.TypeString {
+		return "", fmt.Errorf("attribute %s is of unsupported element type: %d", tfAttributeName, attributeInfo.tfElemType)
+	}
+
+	var tfList []interface{}
+
+	switch v := v.(type) {
+	case *schema.Set:
+		tfList = v.List()
+	case []interface{}:
+		tfList = v
+	}
+
+	if len(tfList) == 0 {
+		return "", nil
+	}
+
+	vs := make([]string, 0, len(tfList))
+
+	for _, v := range tfList {
+		vs = append(vs, v.(string))
+	}
+
+	if attributeInfo.isJSONList {
+		b, err := json.Marshal(vs)
+
+		if err != nil {
+			return "", fmt.Errorf("error encoding %s value into JSON: %w", tfAttributeName, err)
+		}
+
+		return string(b), nil
+	}
+
+	return strings.Join(vs, ","), nil
+}
+
+// listValue returns the Terraform list or set of strings value for an AWS API attribute value.
+func (attributeInfo attributeInfo) listValue(v string) ([]string, error) {
+	if v == "" {
+		return []string{}, nil
+	}
+
+	if attributeInfo.isJSONList {
+		var vs []string
+
+		if err := json.Unmarshal([]byte(v), &vs); err != nil {
+			return nil, err
+		}
+
+		return vs, nil
+	}
+
+	return strings.Split(v, ","), nil
+}
@@ -0,0 +1,214 @@
+package attrmap
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testSchema = map[string]*schema.Schema{
+	"comma_list": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"json_set": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"create_only": {
+		Type:     schema.TypeInt,
+		Optional: true,
+	},
+	"update_only": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"redrive_policy": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"policy": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	},
+}
+
+func testAttributeMap() AttributeMap {
+	return New(map[string]string{
+		"comma_list":     "CommaList",
+		"json_set":       "JSONSet",
+		"create_only":    "CreateOnly",
+		"update_only":    "UpdateOnly",
+		"redrive_policy": "RedrivePolicy",
+		"policy":         "Policy",
+	}, testSchema).
+		WithCreateOnlyAttribute("create_only").
+		WithIAMPolicyAttribute("policy").
+		WithJSONAttribute("redrive_policy").
+		WithJSONListAttribute("json_set").
+		WithUpdateOnlyAttribute("update_only")
+}
+
+func TestResourceDataToApiAttributesCreate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{
+		"comma_list":     []interface{}{"a", "b"},
+		"json_set":       []interface{}{"c"},
+		"create_only":    3,
+		"update_only":    "x",
+		"redrive_policy": `{ "maxReceiveCount": 4 }`,
+	})
+
+	got, err := testAttributeMap().ResourceDataToApiAttributesCreate(d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]string{
+		"CommaList":     "a,b",
+		"JSONSet":       `["c"]`,
+		"CreateOnly":    "3",
+		"RedrivePolicy": `{"maxReceiveCount":4}`,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestResourceDataToApiAttributesCreateInvalidJSON(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{
+		"redrive_policy": `{`,
+	})
+
+	if _, err := testAttributeMap().ResourceDataToApiAttributesCreate(d); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestResourceDataToApiAttributesUpdate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{
+		"comma_list":  []interface{}{"a", "b"},
+		"create_only": 3,
+		"update_only": "x",
+	})
+
+	got, err := testAttributeMap().ResourceDataToApiAttributesUpdate(d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]string{
+		"CommaList":  "a,b",
+		"UpdateOnly": "x",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestApiAttributesToResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{
+		"redrive_policy": `{"deadLetterTargetArn": "arn", "maxReceiveCount": 4}`,
+	})
+
+	err := testAttributeMap().ApiAttributesToResourceData(map[string]string{
+		"CommaList":     "a,b",
+		"JSONSet":       `["c","d"]`,
+		"CreateOnly":    "3",
+		"RedrivePolicy": `{"maxReceiveCount":4,"deadLetterTargetArn":"arn"}`,
+	}, d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := d.Get("comma_list").([]interface{}), []interface{}{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("comma_list: got %v, want %v", got, want)
+	}
+
+	if got, want := d.Get("json_set").(*schema.Set).Len(), 2; got != want {
+		t.Errorf("json_set: got %d elements, want %d", got, want)
+	}
+
+	if got, want := d.Get("create_only").(int), 3; got != want {
+		t.Errorf("create_only: got %d, want %d", got, want)
+	}
+
+	if got, want := d.Get("update_only").(string), ""; got != want {
+		t.Errorf("update_only: got %q, want %q", got, want)
+	}
+
+	// Equivalent JSON keeps the configured value.
+	if got, want := d.Get("redrive_policy").(string), `{"deadLetterTargetArn": "arn", "maxReceiveCount": 4}`; got != want {
+		t.Errorf("redrive_policy: got %q, want %q", got, want)
+	}
+}
+
+func TestApiAttributesToResourceDataIAMPolicy(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Existing string
+		Policy   string
+		Expected string
+	}{
+		{
+			Name:     "new",
+			Policy:   `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:*", "Resource": "*"}]}`,
+			Expected: `{"Statement":[{"Action":"sqs:*","Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`,
+		},
+		{
+			Name:     "equivalent",
+			Existing: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:*"],"Resource":"*"}]}`,
+			Policy:   `{"Statement":[{"Action":"sqs:*","Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`,
+			Expected: `{"Statement":[{"Action":["sqs:*"],"Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`,
+		},
+		{
+			Name:     "changed",
+			Existing: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*"}]}`,
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"sqs:*","Resource":"*"}]}`,
+			Expected: `{"Statement":[{"Action":"sqs:*","Effect":"Deny","Resource":"*"}],"Version":"2012-10-17"}`,
+		},
+		{
+			Name: "empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{
+				"policy": testCase.Existing,
+			})
+
+			err := testAttributeMap().ApiAttributesToResourceData(map[string]string{
+				"Policy": testCase.Policy,
+			}, d)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get("policy").(string); got != testCase.Expected {
+				t.Errorf("got %q, want %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestApiAttributesToResourceDataIAMPolicyInvalidJSON(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{})
+
+	err := testAttributeMap().ApiAttributesToResourceData(map[string]string{
+		"Policy": `{`,
+	}, d)
+
+	if err == nil {
+		t.Error("expected error, got none")
+	}
+}
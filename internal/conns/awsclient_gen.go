@@ -315,6 +315,7 @@ type AWSClient struct {
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
 	Region                    string
+	RequiredTagsConfig        *tftags.RequiredConfig
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
 	Session                   *session.Session
@@ -3,6 +3,7 @@ package conns
 import (
 	"context"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
@@ -54,12 +55,16 @@ type Config struct {
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	HTTPClient                     *http.Client
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimit                      *RateLimitConfig
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
+	Retry                          *RetryConfig
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -78,6 +83,14 @@ type Config struct {
 
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
+	if err := c.Retry.validate(); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if err := c.RateLimit.validate(); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		APNInfo:                       StdUserAgentProducts(c.TerraformVersion),
@@ -125,11 +138,30 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
-	cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
+	if c.Retry != nil && c.Retry.MaxAttempts > 0 {
+		awsbaseConfig.MaxRetries = c.Retry.MaxAttempts
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = httpClientFromContext(ctx)
+	}
+
+	// Credentials validation in GetAwsConfig cannot use a custom HTTP client.
+	// They are validated when retrieving account details instead.
+	getAwsConfigConfig := awsbaseConfig
+	if c.HTTPClient != nil {
+		getAwsConfigConfig.SkipCredsValidation = true
+	}
+
+	cfg, err := awsbase.GetAwsConfig(ctx, &getAwsConfigConfig)
 	if err != nil {
 		return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
 	}
 
+	if c.HTTPClient != nil {
+		cfg.HTTPClient = c.HTTPClient
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -142,6 +174,23 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		return nil, diag.Errorf("error creating AWS SDK v1 session: %s", err)
 	}
 
+	if c.HTTPClient != nil {
+		sess = sess.Copy(&aws.Config{HTTPClient: c.HTTPClient})
+	}
+
+	// The retry policy and rate limits are applied to the session, so that all
+	// clients created from it inherit them.
+	if c.Retry != nil {
+		sess = sess.Copy(&aws.Config{Retryer: c.Retry.retryerV1(aws.IntValue(sess.Config.MaxRetries))})
+		cfg.Retryer = c.Retry.retryerV2(cfg.Retryer)
+	}
+
+	var limiters *rateLimiters
+	if c.RateLimit != nil || c.Retry.adaptive() {
+		limiters = newRateLimiters(c.RateLimit, c.Retry.adaptive())
+		limiters.addHandlers(&sess.Handlers)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error retrieving account details: %s", err)
@@ -184,6 +233,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
@@ -195,6 +245,10 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
 			o.Region = endpoints.UsEast1RegionID
 		}
+
+		if limiters != nil {
+			o.APIOptions = append(o.APIOptions, limiters.apiOption(route53domains.ServiceID, o.Region))
+		}
 	})
 
 	// sts
@@ -517,3 +571,19 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 
 	return client, nil
 }
+
+type httpClientContextKey struct{}
+
+// NewContextWithHTTPClient returns a context carrying an HTTP client that
+// Config.Client uses for all AWS API requests when Config.HTTPClient is not set.
+// It allows acceptance tests to record and replay API calls made by provider
+// instances that are configured by Terraform.
+func NewContextWithHTTPClient(ctx context.Context, client *http.Client) context.Context {
+	return context.WithValue(ctx, httpClientContextKey{}, client)
+}
+
+func httpClientFromContext(ctx context.Context) *http.Client {
+	client, _ := ctx.Value(httpClientContextKey{}).(*http.Client)
+
+	return client
+}
@@ -2,6 +2,7 @@
 package conns
 
 import (
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
@@ -124,6 +125,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/gluedatabrew"
 	"github.com/aws/aws-sdk-go/service/greengrass"
@@ -240,8 +242,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/robomaker"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/aws/aws-sdk-go/service/s3outposts"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
@@ -259,6 +265,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/aws/aws-sdk-go/service/simpledb"
 	"github.com/aws/aws-sdk-go/service/sms"
@@ -273,6 +280,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/ssooidc"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/support"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
@@ -593,3 +601,306 @@ func (c *Config) clientConns(sess *session.Session) *AWSClient {
 		XRayConn:                         xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])})),
 	}
 }
+
+// serviceIDs maps provider service packages to the service IDs of their AWS SDK clients.
+var serviceIDs = map[string]string{
+	names.ACM:                          acm.ServiceID,
+	names.ACMPCA:                       acmpca.ServiceID,
+	names.AMP:                          prometheusservice.ServiceID,
+	names.APIGateway:                   apigateway.ServiceID,
+	names.APIGatewayManagementAPI:      apigatewaymanagementapi.ServiceID,
+	names.APIGatewayV2:                 apigatewayv2.ServiceID,
+	names.AccessAnalyzer:               accessanalyzer.ServiceID,
+	names.Account:                      account.ServiceID,
+	names.AlexaForBusiness:             alexaforbusiness.ServiceID,
+	names.Amplify:                      amplify.ServiceID,
+	names.AmplifyBackend:               amplifybackend.ServiceID,
+	names.AmplifyUIBuilder:             amplifyuibuilder.ServiceID,
+	names.AppAutoScaling:               applicationautoscaling.ServiceID,
+	names.AppConfig:                    appconfig.ServiceID,
+	names.AppConfigData:                appconfigdata.ServiceID,
+	names.AppFlow:                      appflow.ServiceID,
+	names.AppIntegrations:              appintegrationsservice.ServiceID,
+	names.AppMesh:                      appmesh.ServiceID,
+	names.AppRunner:                    apprunner.ServiceID,
+	names.AppStream:                    appstream.ServiceID,
+	names.AppSync:                      appsync.ServiceID,
+	names.ApplicationCostProfiler:      applicationcostprofiler.ServiceID,
+	names.ApplicationInsights:          applicationinsights.ServiceID,
+	names.Athena:                       athena.ServiceID,
+	names.AuditManager:                 auditmanager.ServiceID,
+	names.AutoScaling:                  autoscaling.ServiceID,
+	names.AutoScalingPlans:             autoscalingplans.ServiceID,
+	names.Backup:                       backup.ServiceID,
+	names.BackupGateway:                backupgateway.ServiceID,
+	names.Batch:                        batch.ServiceID,
+	names.BillingConductor:             billingconductor.ServiceID,
+	names.Braket:                       braket.ServiceID,
+	names.Budgets:                      budgets.ServiceID,
+	names.CE:                           costexplorer.ServiceID,
+	names.CUR:                          costandusagereportservice.ServiceID,
+	names.Chime:                        chime.ServiceID,
+	names.ChimeSDKIdentity:             chimesdkidentity.ServiceID,
+	names.ChimeSDKMeetings:             chimesdkmeetings.ServiceID,
+	names.ChimeSDKMessaging:            chimesdkmessaging.ServiceID,
+	names.Cloud9:                       cloud9.ServiceID,
+	names.CloudControl:                 cloudcontrolapi.ServiceID,
+	names.CloudDirectory:               clouddirectory.ServiceID,
+	names.CloudFormation:               cloudformation.ServiceID,
+	names.CloudFront:                   cloudfront.ServiceID,
+	names.CloudHSMV2:                   cloudhsmv2.ServiceID,
+	names.CloudSearch:                  cloudsearch.ServiceID,
+	names.CloudSearchDomain:            cloudsearchdomain.ServiceID,
+	names.CloudTrail:                   cloudtrail.ServiceID,
+	names.CloudWatch:                   cloudwatch.ServiceID,
+	names.CodeArtifact:                 codeartifact.ServiceID,
+	names.CodeBuild:                    codebuild.ServiceID,
+	names.CodeCommit:                   codecommit.ServiceID,
+	names.CodeGuruProfiler:             codeguruprofiler.ServiceID,
+	names.CodeGuruReviewer:             codegurureviewer.ServiceID,
+	names.CodePipeline:                 codepipeline.ServiceID,
+	names.CodeStar:                     codestar.ServiceID,
+	names.CodeStarConnections:          codestarconnections.ServiceID,
+	names.CodeStarNotifications:        codestarnotifications.ServiceID,
+	names.CognitoIDP:                   cognitoidentityprovider.ServiceID,
+	names.CognitoIdentity:              cognitoidentity.ServiceID,
+	names.CognitoSync:                  cognitosync.ServiceID,
+	names.Comprehend:                   comprehend.ServiceID,
+	names.ComprehendMedical:            comprehendmedical.ServiceID,
+	names.ComputeOptimizer:             computeoptimizer.ServiceID,
+	names.ConfigService:                configservice.ServiceID,
+	names.Connect:                      connect.ServiceID,
+	names.ConnectContactLens:           connectcontactlens.ServiceID,
+	names.ConnectParticipant:           connectparticipant.ServiceID,
+	names.CustomerProfiles:             customerprofiles.ServiceID,
+	names.DAX:                          dax.ServiceID,
+	names.DLM:                          dlm.ServiceID,
+	names.DMS:                          databasemigrationservice.ServiceID,
+	names.DRS:                          drs.ServiceID,
+	names.DS:                           directoryservice.ServiceID,
+	names.DataBrew:                     gluedatabrew.ServiceID,
+	names.DataExchange:                 dataexchange.ServiceID,
+	names.DataPipeline:                 datapipeline.ServiceID,
+	names.DataSync:                     datasync.ServiceID,
+	names.Deploy:                       codedeploy.ServiceID,
+	names.Detective:                    detective.ServiceID,
+	names.DevOpsGuru:                   devopsguru.ServiceID,
+	names.DeviceFarm:                   devicefarm.ServiceID,
+	names.DirectConnect:                directconnect.ServiceID,
+	names.Discovery:                    applicationdiscoveryservice.ServiceID,
+	names.DocDB:                        docdb.ServiceID,
+	names.DynamoDB:                     dynamodb.ServiceID,
+	names.DynamoDBStreams:              dynamodbstreams.ServiceID,
+	names.EBS:                          ebs.ServiceID,
+	names.EC2:                          ec2.ServiceID,
+	names.EC2InstanceConnect:           ec2instanceconnect.ServiceID,
+	names.ECR:                          ecr.ServiceID,
+	names.ECRPublic:                    ecrpublic.ServiceID,
+	names.ECS:                          ecs.ServiceID,
+	names.EFS:                          efs.ServiceID,
+	names.EKS:                          eks.ServiceID,
+	names.ELB:                          elb.ServiceID,
+	names.ELBV2:                        elbv2.ServiceID,
+	names.EMR:                          emr.ServiceID,
+	names.EMRContainers:                emrcontainers.ServiceID,
+	names.ElastiCache:                  elasticache.ServiceID,
+	names.ElasticBeanstalk:             elasticbeanstalk.ServiceID,
+	names.ElasticInference:             elasticinference.ServiceID,
+	names.ElasticTranscoder:            elastictranscoder.ServiceID,
+	names.Elasticsearch:                elasticsearchservice.ServiceID,
+	names.Events:                       eventbridge.ServiceID,
+	names.Evidently:                    cloudwatchevidently.ServiceID,
+	names.FIS:                          fis.ServiceID,
+	names.FMS:                          fms.ServiceID,
+	names.FSx:                          fsx.ServiceID,
+	names.FinSpace:                     finspace.ServiceID,
+	names.FinSpaceData:                 finspacedata.ServiceID,
+	names.Firehose:                     firehose.ServiceID,
+	names.Forecast:                     forecastservice.ServiceID,
+	names.ForecastQuery:                forecastqueryservice.ServiceID,
+	names.FraudDetector:                frauddetector.ServiceID,
+	names.GameLift:                     gamelift.ServiceID,
+	names.Glacier:                      glacier.ServiceID,
+	names.GlobalAccelerator:            globalaccelerator.ServiceID,
+	names.Glue:                         glue.ServiceID,
+	names.Grafana:                      managedgrafana.ServiceID,
+	names.Greengrass:                   greengrass.ServiceID,
+	names.GreengrassV2:                 greengrassv2.ServiceID,
+	names.GroundStation:                groundstation.ServiceID,
+	names.GuardDuty:                    guardduty.ServiceID,
+	names.Health:                       health.ServiceID,
+	names.HealthLake:                   healthlake.ServiceID,
+	names.Honeycode:                    honeycode.ServiceID,
+	names.IAM:                          iam.ServiceID,
+	names.IVS:                          ivs.ServiceID,
+	names.IdentityStore:                identitystore.ServiceID,
+	names.ImageBuilder:                 imagebuilder.ServiceID,
+	names.Inspector:                    inspector.ServiceID,
+	names.Inspector2:                   inspector2.ServiceID,
+	names.IoT:                          iot.ServiceID,
+	names.IoT1ClickDevices:             iot1clickdevicesservice.ServiceID,
+	names.IoT1ClickProjects:            iot1clickprojects.ServiceID,
+	names.IoTAnalytics:                 iotanalytics.ServiceID,
+	names.IoTData:                      iotdataplane.ServiceID,
+	names.IoTDeviceAdvisor:             iotdeviceadvisor.ServiceID,
+	names.IoTEvents:                    iotevents.ServiceID,
+	names.IoTEventsData:                ioteventsdata.ServiceID,
+	names.IoTFleetHub:                  iotfleethub.ServiceID,
+	names.IoTJobsData:                  iotjobsdataplane.ServiceID,
+	names.IoTSecureTunneling:           iotsecuretunneling.ServiceID,
+	names.IoTSiteWise:                  iotsitewise.ServiceID,
+	names.IoTThingsGraph:               iotthingsgraph.ServiceID,
+	names.IoTTwinMaker:                 iottwinmaker.ServiceID,
+	names.IoTWireless:                  iotwireless.ServiceID,
+	names.KMS:                          kms.ServiceID,
+	names.Kafka:                        kafka.ServiceID,
+	names.KafkaConnect:                 kafkaconnect.ServiceID,
+	names.Kendra:                       kendra.ServiceID,
+	names.Keyspaces:                    keyspaces.ServiceID,
+	names.Kinesis:                      kinesis.ServiceID,
+	names.KinesisAnalytics:             kinesisanalytics.ServiceID,
+	names.KinesisAnalyticsV2:           kinesisanalyticsv2.ServiceID,
+	names.KinesisVideo:                 kinesisvideo.ServiceID,
+	names.KinesisVideoArchivedMedia:    kinesisvideoarchivedmedia.ServiceID,
+	names.KinesisVideoMedia:            kinesisvideomedia.ServiceID,
+	names.KinesisVideoSignaling:        kinesisvideosignalingchannels.ServiceID,
+	names.LakeFormation:                lakeformation.ServiceID,
+	names.Lambda:                       lambda.ServiceID,
+	names.LexModels:                    lexmodelbuildingservice.ServiceID,
+	names.LexModelsV2:                  lexmodelsv2.ServiceID,
+	names.LexRuntime:                   lexruntimeservice.ServiceID,
+	names.LexRuntimeV2:                 lexruntimev2.ServiceID,
+	names.LicenseManager:               licensemanager.ServiceID,
+	names.Lightsail:                    lightsail.ServiceID,
+	names.Location:                     locationservice.ServiceID,
+	names.Logs:                         cloudwatchlogs.ServiceID,
+	names.LookoutEquipment:             lookoutequipment.ServiceID,
+	names.LookoutMetrics:               lookoutmetrics.ServiceID,
+	names.LookoutVision:                lookoutforvision.ServiceID,
+	names.MQ:                           mq.ServiceID,
+	names.MTurk:                        mturk.ServiceID,
+	names.MWAA:                         mwaa.ServiceID,
+	names.MachineLearning:              machinelearning.ServiceID,
+	names.Macie:                        macie.ServiceID,
+	names.Macie2:                       macie2.ServiceID,
+	names.ManagedBlockchain:            managedblockchain.ServiceID,
+	names.MarketplaceCatalog:           marketplacecatalog.ServiceID,
+	names.MarketplaceCommerceAnalytics: marketplacecommerceanalytics.ServiceID,
+	names.MarketplaceEntitlement:       marketplaceentitlementservice.ServiceID,
+	names.MarketplaceMetering:          marketplacemetering.ServiceID,
+	names.MediaConnect:                 mediaconnect.ServiceID,
+	names.MediaConvert:                 mediaconvert.ServiceID,
+	names.MediaLive:                    medialive.ServiceID,
+	names.MediaPackage:                 mediapackage.ServiceID,
+	names.MediaPackageVOD:              mediapackagevod.ServiceID,
+	names.MediaStore:                   mediastore.ServiceID,
+	names.MediaStoreData:               mediastoredata.ServiceID,
+	names.MediaTailor:                  mediatailor.ServiceID,
+	names.MemoryDB:                     memorydb.ServiceID,
+	names.MgH:                          migrationhub.ServiceID,
+	names.Mgn:                          mgn.ServiceID,
+	names.MigrationHubConfig:           migrationhubconfig.ServiceID,
+	names.MigrationHubRefactorSpaces:   migrationhubrefactorspaces.ServiceID,
+	names.MigrationHubStrategy:         migrationhubstrategyrecommendations.ServiceID,
+	names.Mobile:                       mobile.ServiceID,
+	names.Neptune:                      neptune.ServiceID,
+	names.NetworkFirewall:              networkfirewall.ServiceID,
+	names.NetworkManager:               networkmanager.ServiceID,
+	names.Nimble:                       nimblestudio.ServiceID,
+	names.OpenSearch:                   opensearchservice.ServiceID,
+	names.OpsWorks:                     opsworks.ServiceID,
+	names.OpsWorksCM:                   opsworkscm.ServiceID,
+	names.Organizations:                organizations.ServiceID,
+	names.Outposts:                     outposts.ServiceID,
+	names.PI:                           pi.ServiceID,
+	names.Panorama:                     panorama.ServiceID,
+	names.Personalize:                  personalize.ServiceID,
+	names.PersonalizeEvents:            personalizeevents.ServiceID,
+	names.PersonalizeRuntime:           personalizeruntime.ServiceID,
+	names.Pinpoint:                     pinpoint.ServiceID,
+	names.PinpointEmail:                pinpointemail.ServiceID,
+	names.PinpointSMSVoice:             pinpointsmsvoice.ServiceID,
+	names.Polly:                        polly.ServiceID,
+	names.Pricing:                      pricing.ServiceID,
+	names.Proton:                       proton.ServiceID,
+	names.QLDB:                         qldb.ServiceID,
+	names.QLDBSession:                  qldbsession.ServiceID,
+	names.QuickSight:                   quicksight.ServiceID,
+	names.RAM:                          ram.ServiceID,
+	names.RBin:                         recyclebin.ServiceID,
+	names.RDS:                          rds.ServiceID,
+	names.RDSData:                      rdsdataservice.ServiceID,
+	names.RUM:                          cloudwatchrum.ServiceID,
+	names.Redshift:                     redshift.ServiceID,
+	names.RedshiftData:                 redshiftdataapiservice.ServiceID,
+	names.Rekognition:                  rekognition.ServiceID,
+	names.ResilienceHub:                resiliencehub.ServiceID,
+	names.ResourceGroups:               resourcegroups.ServiceID,
+	names.ResourceGroupsTaggingAPI:     resourcegroupstaggingapi.ServiceID,
+	names.RoboMaker:                    robomaker.ServiceID,
+	names.Route53:                      route53.ServiceID,
+	names.Route53Domains:               route53domains.ServiceID,
+	names.Route53RecoveryCluster:       route53recoverycluster.ServiceID,
+	names.Route53RecoveryControlConfig: route53recoverycontrolconfig.ServiceID,
+	names.Route53RecoveryReadiness:     route53recoveryreadiness.ServiceID,
+	names.Route53Resolver:              route53resolver.ServiceID,
+	names.S3:                           s3.ServiceID,
+	names.S3Control:                    s3control.ServiceID,
+	names.S3Outposts:                   s3outposts.ServiceID,
+	names.SES:                          ses.ServiceID,
+	names.SESV2:                        sesv2.ServiceID,
+	names.SFN:                          sfn.ServiceID,
+	names.SMS:                          sms.ServiceID,
+	names.SNS:                          sns.ServiceID,
+	names.SQS:                          sqs.ServiceID,
+	names.SSM:                          ssm.ServiceID,
+	names.SSMContacts:                  ssmcontacts.ServiceID,
+	names.SSMIncidents:                 ssmincidents.ServiceID,
+	names.SSO:                          sso.ServiceID,
+	names.SSOAdmin:                     ssoadmin.ServiceID,
+	names.SSOOIDC:                      ssooidc.ServiceID,
+	names.STS:                          sts.ServiceID,
+	names.SWF:                          swf.ServiceID,
+	names.SageMaker:                    sagemaker.ServiceID,
+	names.SageMakerA2IRuntime:          augmentedairuntime.ServiceID,
+	names.SageMakerEdge:                sagemakeredgemanager.ServiceID,
+	names.SageMakerFeatureStoreRuntime: sagemakerfeaturestoreruntime.ServiceID,
+	names.SageMakerRuntime:             sagemakerruntime.ServiceID,
+	names.SavingsPlans:                 savingsplans.ServiceID,
+	names.Schemas:                      schemas.ServiceID,
+	names.SecretsManager:               secretsmanager.ServiceID,
+	names.SecurityHub:                  securityhub.ServiceID,
+	names.ServerlessRepo:               serverlessapplicationrepository.ServiceID,
+	names.ServiceCatalog:               servicecatalog.ServiceID,
+	names.ServiceCatalogAppRegistry:    appregistry.ServiceID,
+	names.ServiceDiscovery:             servicediscovery.ServiceID,
+	names.ServiceQuotas:                servicequotas.ServiceID,
+	names.Shield:                       shield.ServiceID,
+	names.Signer:                       signer.ServiceID,
+	names.SimpleDB:                     simpledb.ServiceID,
+	names.SnowDeviceManagement:         snowdevicemanagement.ServiceID,
+	names.Snowball:                     snowball.ServiceID,
+	names.StorageGateway:               storagegateway.ServiceID,
+	names.Support:                      support.ServiceID,
+	names.Synthetics:                   synthetics.ServiceID,
+	names.Textract:                     textract.ServiceID,
+	names.TimestreamQuery:              timestreamquery.ServiceID,
+	names.TimestreamWrite:              timestreamwrite.ServiceID,
+	names.Transcribe:                   transcribeservice.ServiceID,
+	names.TranscribeStreaming:          transcribestreamingservice.ServiceID,
+	names.Transfer:                     transfer.ServiceID,
+	names.Translate:                    translate.ServiceID,
+	names.VoiceID:                      voiceid.ServiceID,
+	names.WAF:                          waf.ServiceID,
+	names.WAFRegional:                  wafregional.ServiceID,
+	names.WAFV2:                        wafv2.ServiceID,
+	names.WellArchitected:              wellarchitected.ServiceID,
+	names.Wisdom:                       connectwisdomservice.ServiceID,
+	names.WorkDocs:                     workdocs.ServiceID,
+	names.WorkLink:                     worklink.ServiceID,
+	names.WorkMail:                     workmail.ServiceID,
+	names.WorkMailMessageFlow:          workmailmessageflow.ServiceID,
+	names.WorkSpaces:                   workspaces.ServiceID,
+	names.WorkSpacesWeb:                workspacesweb.ServiceID,
+	names.XRay:                         xray.ServiceID,
+}
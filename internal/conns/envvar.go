@@ -54,6 +54,14 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// Directory containing acceptance test VCR cassettes.
+	// Defaults to "testdata/cassettes" relative to the test's package directory.
+	EnvVarAccCassetteDir = "TF_ACC_CASSETTE_DIR"
+
+	// Acceptance test VCR mode, either "record" or "replay".
+	// In record mode AWS API calls are recorded to cassettes, in replay mode they are served from cassettes without credentials.
+	EnvVarAccCassetteMode = "TF_ACC_CASSETTE_MODE"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -72,6 +80,42 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control how resource sweepers run
+const (
+	// The maximum number of sweepers, and resource deletions, run concurrently per region in ordered mode.
+	// Defaults to 10.
+	EnvVarSweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// Report what would be swept without deleting anything. Implies ordered mode.
+	EnvVarSweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated "key" or "key=value" tags. Resources with any matching tag are not swept.
+	// Values may contain "*" wildcards.
+	EnvVarSweepExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+
+	// Resources whose name or ID matches this regular expression are not swept
+	EnvVarSweepExcludeNameRegex = "TF_AWS_SWEEP_EXCLUDE_NAME_REGEX"
+
+	// Comma-separated "key" or "key=value" tags. Only resources with at least one matching tag are swept.
+	// Values may contain "*" wildcards.
+	EnvVarSweepIncludeTags = "TF_AWS_SWEEP_INCLUDE_TAGS"
+
+	// Only resources whose name or ID matches this regular expression are swept
+	EnvVarSweepIncludeNameRegex = "TF_AWS_SWEEP_INCLUDE_NAME_REGEX"
+
+	// A duration, such as "24h". Resources created more recently, or whose creation time is unknown, are not swept.
+	EnvVarSweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Run sweepers in dependency order, level by level, with bounded per-region concurrency
+	EnvVarSweepOrdered = "TF_AWS_SWEEP_ORDERED"
+
+	// The file the dry-run report is written to. Defaults to standard output.
+	EnvVarSweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+
+	// The format of the dry-run report, either "json" or "markdown". Defaults to "json".
+	EnvVarSweepReportFormat = "TF_AWS_SWEEP_REPORT_FORMAT"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -1,8 +1,11 @@
 package conns
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"sync"
+	"time"
 )
 
 // GlobalMutexKV is a global MutexKV for use within this plugin.
@@ -11,33 +14,76 @@ var GlobalMutexKV = NewMutexKV()
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// Each key's mutex is a reader/writer mutex: any number of readers can hold it
+// at once, while a writer holds it exclusively. Waiting writers take precedence
+// over new readers.
 type MutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+}
+
+// LockTimeoutError is returned when the context passed to LockWithContext or
+// RLockWithContext is done before the lock for a key is acquired.
+type LockTimeoutError struct {
+	Key     string
+	Waited  time.Duration
+	Holders string // Describes the holders of the lock when waiting stopped.
+	Err     error
+}
+
+func (e *LockTimeoutError) Error() string {
+	return fmt.Sprintf("timeout after waiting %s for lock %q (%s): %s", e.Waited.Round(time.Millisecond), e.Key, e.Holders, e.Err)
+}
+
+func (e *LockTimeoutError) Unwrap() error {
+	return e.Err
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *MutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, waiting until the context
+// is done. If the lock is not acquired a *LockTimeoutError is returned and the
+// caller must not call Unlock.
+func (m *MutexKV) LockWithContext(ctx context.Context, key string) error {
+	return m.get(key).lock(ctx, key, false)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *MutexKV) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
+	m.get(key).unlock(key, false)
+}
+
+// RLock locks the mutex for the given key for reading. Caller is responsible
+// for calling RUnlock for the same key
+func (m *MutexKV) RLock(key string) {
+	_ = m.RLockWithContext(context.Background(), key)
+}
+
+// RLockWithContext locks the mutex for the given key for reading, waiting until
+// the context is done. If the lock is not acquired a *LockTimeoutError is returned
+// and the caller must not call RUnlock.
+func (m *MutexKV) RLockWithContext(ctx context.Context, key string) error {
+	return m.get(key).lock(ctx, key, true)
+}
+
+// RUnlock unlocks the mutex for the given key for reading. Caller must have called
+// RLock for the same key first
+func (m *MutexKV) RUnlock(key string) {
+	m.get(key).unlock(key, true)
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
+func (m *MutexKV) get(key string) *keyMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = newKeyMutex()
 		m.store[key] = mutex
 	}
 	return mutex
@@ -46,6 +92,177 @@ func (m *MutexKV) get(key string) *sync.Mutex {
 // Returns a properly initialized MutexKV
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyMutex),
+	}
+}
+
+// keyMutex is a context-aware reader/writer mutex that tracks its holders and
+// waiters for diagnostics.
+type keyMutex struct {
+	mu sync.Mutex
+
+	writer         bool
+	readers        int
+	waitingWriters int
+	waitingReaders int
+
+	// lockedAt is when the mutex was acquired by a writer or the first reader.
+	lockedAt time.Time
+
+	// released is closed, and replaced, whenever the mutex is unlocked.
+	released chan struct{}
+}
+
+func newKeyMutex() *keyMutex {
+	return &keyMutex{
+		released: make(chan struct{}),
 	}
 }
+
+func (km *keyMutex) lock(ctx context.Context, key string, read bool) error {
+	mode := lockMode(read)
+	start := time.Now()
+	waiting := false
+
+	km.mu.Lock()
+
+	for {
+		var acquired bool
+
+		if read {
+			acquired = !km.writer && km.waitingWriters == 0
+		} else {
+			acquired = !km.writer && km.readers == 0
+		}
+
+		if acquired {
+			if read {
+				km.readers++
+			} else {
+				km.writer = true
+			}
+
+			if waiting {
+				km.removeWaiter(read)
+			}
+
+			if !read || km.readers == 1 {
+				km.lockedAt = time.Now()
+			}
+
+			km.mu.Unlock()
+
+			if waiting {
+				log.Printf("[DEBUG] Locked %q for %s after waiting %s", key, mode, time.Since(start).Round(time.Millisecond))
+			} else {
+				log.Printf("[DEBUG] Locked %q for %s", key, mode)
+			}
+
+			return nil
+		}
+
+		if !waiting {
+			waiting = true
+
+			if read {
+				km.waitingReaders++
+			} else {
+				km.waitingWriters++
+			}
+
+			log.Printf("[DEBUG] Waiting to lock %q for %s: %s, %d writer(s) and %d reader(s) waiting", key, mode, km.holders(), km.waitingWriters, km.waitingReaders)
+		}
+
+		released := km.released
+		km.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			km.mu.Lock()
+			km.removeWaiter(read)
+
+			err := &LockTimeoutError{
+				Key:     key,
+				Waited:  time.Since(start),
+				Holders: km.holders(),
+				Err:     ctx.Err(),
+			}
+
+			// Readers may be waiting on this writer.
+			if !read {
+				km.broadcast()
+			}
+
+			km.mu.Unlock()
+
+			log.Printf("[WARN] %s", err)
+
+			return err
+		case <-released:
+		}
+
+		km.mu.Lock()
+	}
+}
+
+func (km *keyMutex) unlock(key string, read bool) {
+	km.mu.Lock()
+
+	if read {
+		if km.readers == 0 {
+			km.mu.Unlock()
+			panic(fmt.Sprintf("RUnlock of unlocked key %q", key))
+		}
+		km.readers--
+	} else {
+		if !km.writer {
+			km.mu.Unlock()
+			panic(fmt.Sprintf("Unlock of unlocked key %q", key))
+		}
+		km.writer = false
+	}
+
+	held := time.Since(km.lockedAt)
+	km.broadcast()
+	km.mu.Unlock()
+
+	log.Printf("[DEBUG] Unlocked %q for %s, held for %s", key, lockMode(read), held.Round(time.Millisecond))
+}
+
+// broadcast wakes all waiters. Callers must hold km.mu.
+func (km *keyMutex) broadcast() {
+	close(km.released)
+	km.released = make(chan struct{})
+}
+
+// removeWaiter callers must hold km.mu.
+func (km *keyMutex) removeWaiter(read bool) {
+	if read {
+		km.waitingReaders--
+	} else {
+		km.waitingWriters--
+	}
+}
+
+// holders describes the current holders of the mutex. Callers must hold km.mu.
+func (km *keyMutex) holders() string {
+	held := time.Since(km.lockedAt).Round(time.Millisecond)
+
+	if km.writer {
+		return fmt.Sprintf("held by a writer for %s", held)
+	}
+
+	if km.readers > 0 {
+		return fmt.Sprintf("held by %d reader(s) for %s", km.readers, held)
+	}
+
+	return "not held"
+}
+
+func lockMode(read bool) string {
+	if read {
+		return "reading"
+	}
+
+	return "writing"
+}
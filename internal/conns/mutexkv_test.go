@@ -1,6 +1,8 @@
 package conns
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 )
@@ -65,3 +67,99 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockWithContext(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockWithContext(ctx, "foo")
+
+	var lockErr *LockTimeoutError
+	if !errors.As(err, &lockErr) {
+		t.Fatalf("expected LockTimeoutError, got %v", err)
+	}
+
+	if lockErr.Key != "foo" {
+		t.Errorf("got key %q, expected %q", lockErr.Key, "foo")
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockWithContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVRLock(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.RLock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.RLock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second read lock blocked. This shouldn't happen.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockWithContext(ctx, "foo"); err == nil {
+		t.Fatal("Write lock was able to be taken while read locked. This shouldn't happen.")
+	}
+
+	mkv.RUnlock("foo")
+	mkv.RUnlock("foo")
+
+	if err := mkv.LockWithContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVRLockWaitingWriter(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.RLock("foo")
+
+	lockedCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(lockedCh)
+	}()
+
+	// Wait for the writer to start waiting.
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.RLockWithContext(ctx, "foo"); err == nil {
+		t.Fatal("Read lock was able to be taken while a writer was waiting. This shouldn't happen.")
+	}
+
+	mkv.RUnlock("foo")
+
+	select {
+	case <-lockedCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Writer blocked after read unlock. This shouldn't happen.")
+	}
+}
@@ -0,0 +1,325 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// adaptiveMinRate is the lowest request rate, in requests per second, that
+	// adaptive retry mode reduces a throttled endpoint to.
+	adaptiveMinRate = 0.5
+
+	// adaptiveRateIncrease is the request rate increase, in requests per second,
+	// after each successful request to a throttled endpoint in adaptive retry mode.
+	adaptiveRateIncrease = 0.1
+)
+
+// RateLimit is a client-side request rate limit.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained request rate. Zero is unlimited.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that can be sent at once before the rate
+	// applies. Zero defaults to RequestsPerSecond, rounded up.
+	Burst int
+}
+
+func (l RateLimit) burst() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+
+	return math.Max(1, math.Ceil(l.RequestsPerSecond))
+}
+
+// RateLimitConfig is the request rate limit applied to each AWS service endpoint.
+type RateLimitConfig struct {
+	RateLimit
+
+	// Services are rate limits for individual services, keyed by provider service
+	// package name (e.g. "route53"). They take precedence over the default limit.
+	Services map[string]RateLimit
+}
+
+func (c *RateLimitConfig) validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.RequestsPerSecond < 0 {
+		return fmt.Errorf("rate_limit requests_per_second (%g) must not be negative", c.RequestsPerSecond)
+	}
+
+	for service, l := range c.Services {
+		if _, ok := serviceIDs[service]; !ok {
+			return fmt.Errorf("rate_limit: unsupported service (%s)", service)
+		}
+
+		if l.RequestsPerSecond < 0 {
+			return fmt.Errorf("rate_limit service (%s) requests_per_second (%g) must not be negative", service, l.RequestsPerSecond)
+		}
+	}
+
+	return nil
+}
+
+// rateLimiters holds a rate limiter for each service endpoint that requests are sent to.
+type rateLimiters struct {
+	config   RateLimitConfig
+	adaptive bool
+
+	// services maps AWS SDK client service IDs to provider service package names.
+	services map[string]string
+
+	mu       sync.Mutex
+	limiters map[string]*rateLimiter
+}
+
+func newRateLimiters(config *RateLimitConfig, adaptive bool) *rateLimiters {
+	ls := &rateLimiters{
+		adaptive: adaptive,
+		services: make(map[string]string, len(serviceIDs)),
+		limiters: make(map[string]*rateLimiter),
+	}
+
+	if config != nil {
+		ls.config = *config
+	}
+
+	for service, serviceID := range serviceIDs {
+		ls.services[serviceID] = service
+	}
+
+	return ls
+}
+
+// get returns the rate limiter for requests to the specified service endpoint,
+// or nil if requests to it are not limited.
+func (ls *rateLimiters) get(serviceID, endpoint string) *rateLimiter {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	key := serviceID + " " + endpoint
+
+	if l, ok := ls.limiters[key]; ok {
+		return l
+	}
+
+	limit := ls.config.RateLimit
+
+	if l, ok := ls.config.Services[ls.services[serviceID]]; ok {
+		limit = l
+	}
+
+	var l *rateLimiter
+
+	if limit.RequestsPerSecond > 0 {
+		log.Printf("[DEBUG] Rate limiting requests to %s (%s): %g requests per second", serviceID, endpoint, limit.RequestsPerSecond)
+	}
+
+	if limit.RequestsPerSecond > 0 || ls.adaptive {
+		l = newRateLimiter(limit)
+	}
+
+	ls.limiters[key] = l
+
+	return l
+}
+
+// addHandlers adds request handlers that limit the rate of requests sent by
+// AWS SDK for Go v1 clients, and in adaptive mode adjust the rate on throttling.
+func (ls *rateLimiters) addHandlers(handlers *request.Handlers) {
+	// Sign handlers run before each attempt.
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "tf.RateLimit",
+		Fn: func(r *request.Request) {
+			l := ls.get(r.ClientInfo.ServiceID, r.ClientInfo.Endpoint)
+
+			if l == nil {
+				return
+			}
+
+			if err := l.wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while rate limited", err)
+			}
+		},
+	})
+
+	if !ls.adaptive {
+		return
+	}
+
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "tf.AdaptiveRateLimit",
+		Fn: func(r *request.Request) {
+			l := ls.get(r.ClientInfo.ServiceID, r.ClientInfo.Endpoint)
+
+			if l == nil {
+				return
+			}
+
+			switch {
+			case r.IsErrorThrottle():
+				l.throttled()
+				log.Printf("[DEBUG] Request to %s (%s) throttled, reducing request rate", r.ClientInfo.ServiceID, r.ClientInfo.Endpoint)
+			case r.Error == nil:
+				l.succeeded()
+			}
+		},
+	})
+}
+
+// apiOption returns an AWS SDK for Go v2 API option that limits the rate of requests
+// sent by a client. Adaptive rate limiting is handled by the v2 adaptive retryer.
+func (ls *rateLimiters) apiOption(serviceID, endpoint string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("tf.RateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if l := ls.get(serviceID, endpoint); l != nil {
+				if err := l.wait(ctx); err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+				}
+			}
+
+			return next.HandleFinalize(ctx, in)
+		}), middleware.After)
+	}
+}
+
+// rateLimiter is a token bucket limiting the rate of requests to a single service endpoint.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	limit  float64 // Configured requests per second, 0 for unlimited.
+	burst  float64
+	rate   float64 // Current requests per second, 0 for unlimited.
+	tokens float64
+	last   time.Time
+
+	// ceiling is the request rate that adaptive mode restores a throttled
+	// endpoint to, after which the configured limit applies again.
+	ceiling float64
+
+	// Requests in the current and previous one second windows, used to estimate
+	// the request rate when an unlimited endpoint is first throttled.
+	window    time.Time
+	count     int
+	prevCount int
+
+	now func() time.Time
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	return &rateLimiter{
+		limit:  limit.RequestsPerSecond,
+		burst:  limit.burst(),
+		rate:   limit.RequestsPerSecond,
+		tokens: limit.burst(),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait before
+// sending the request.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if now.Sub(l.window) >= time.Second {
+		if now.Sub(l.window) < 2*time.Second {
+			l.prevCount = l.count
+		} else {
+			l.prevCount = 0
+		}
+		l.window = now
+		l.count = 0
+	}
+	l.count++
+
+	if l.rate == 0 {
+		l.last = now
+		return 0
+	}
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	// Tokens can go negative, queueing requests behind those already waiting.
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait blocks until a request can be sent or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	d := l.reserve()
+
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttled halves the request rate after a request is throttled.
+func (l *rateLimiter) throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rate := l.rate
+
+	if rate == 0 {
+		// Unlimited, so estimate the rate at which requests were throttled.
+		rate = math.Max(float64(l.count), float64(l.prevCount))
+		l.ceiling = rate
+		l.tokens = math.Min(l.tokens, 0)
+		l.last = l.now()
+	}
+
+	if l.ceiling == 0 {
+		l.ceiling = l.limit
+	}
+
+	l.rate = math.Max(adaptiveMinRate, rate/2)
+}
+
+// succeeded gradually restores the request rate of a throttled endpoint.
+func (l *rateLimiter) succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.ceiling == 0 {
+		return
+	}
+
+	l.rate += adaptiveRateIncrease
+
+	if l.rate >= l.ceiling {
+		l.rate = l.limit
+		l.ceiling = 0
+	}
+}
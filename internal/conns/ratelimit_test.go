@@ -0,0 +1,135 @@
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	l := newRateLimiter(RateLimit{RequestsPerSecond: 2, Burst: 2})
+	l.now = func() time.Time { return now }
+
+	for i, expected := range []time.Duration{0, 0, 500 * time.Millisecond, time.Second} {
+		if got := l.reserve(); got != expected {
+			t.Errorf("request %d: got delay %s, expected %s", i, got, expected)
+		}
+	}
+
+	// Tokens are replenished at the configured rate.
+	now = now.Add(time.Second)
+
+	if got, expected := l.reserve(), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+}
+
+func TestRateLimiter_adaptive(t *testing.T) {
+	now := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	l := newRateLimiter(RateLimit{})
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 10; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, got)
+		}
+	}
+
+	l.throttled()
+
+	if got, expected := l.rate, 5.0; got != expected {
+		t.Errorf("got rate %g after throttling, expected %g", got, expected)
+	}
+
+	if got := l.reserve(); got <= 0 {
+		t.Errorf("expected delay after throttling")
+	}
+
+	l.throttled()
+	l.throttled()
+	l.throttled()
+	l.throttled()
+
+	if got, expected := l.rate, adaptiveMinRate; got != expected {
+		t.Errorf("got rate %g after throttling, expected minimum %g", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.succeeded()
+	}
+
+	if got := l.rate; got != 0 {
+		t.Errorf("got rate %g after succeeding, expected unlimited", got)
+	}
+}
+
+func TestRateLimiters_get(t *testing.T) {
+	ls := newRateLimiters(&RateLimitConfig{
+		RateLimit: RateLimit{RequestsPerSecond: 10},
+		Services: map[string]RateLimit{
+			names.Route53: {RequestsPerSecond: 1},
+		},
+	}, false)
+
+	if got, expected := ls.get(route53.ServiceID, "https://route53.amazonaws.com").limit, 1.0; got != expected {
+		t.Errorf("Route 53: got limit %g, expected %g", got, expected)
+	}
+
+	if got, expected := ls.get(iam.ServiceID, "https://iam.amazonaws.com").limit, 10.0; got != expected {
+		t.Errorf("IAM: got limit %g, expected %g", got, expected)
+	}
+
+	if a, b := ls.get(iam.ServiceID, "https://iam.amazonaws.com"), ls.get(iam.ServiceID, "https://iam.amazonaws.com"); a != b {
+		t.Errorf("expected the same rate limiter for the same endpoint")
+	}
+
+	if ls := newRateLimiters(nil, false); ls.get(iam.ServiceID, "https://iam.amazonaws.com") != nil {
+		t.Errorf("expected no rate limiter without a rate limit")
+	}
+}
+
+func TestRateLimitConfig_validate(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Config    *RateLimitConfig
+		ExpectErr bool
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:   "valid",
+			Config: &RateLimitConfig{RateLimit: RateLimit{RequestsPerSecond: 5}, Services: map[string]RateLimit{names.Route53Domains: {RequestsPerSecond: 1}}},
+		},
+		{
+			Name:      "negative rate",
+			Config:    &RateLimitConfig{RateLimit: RateLimit{RequestsPerSecond: -1}},
+			ExpectErr: true,
+		},
+		{
+			Name:      "unsupported service",
+			Config:    &RateLimitConfig{Services: map[string]RateLimit{"nosuchservice": {RequestsPerSecond: 1}}},
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.validate()
+
+			if err == nil && testCase.ExpectErr {
+				t.Errorf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectErr {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
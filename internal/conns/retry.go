@@ -0,0 +1,119 @@
+package conns
+
+import (
+	"fmt"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retryv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/client"
+)
+
+const (
+	// RetryModeStandard retries failed requests with exponential backoff and jitter.
+	RetryModeStandard = "standard"
+
+	// RetryModeAdaptive additionally reduces the request rate to a service
+	// endpoint when requests are throttled, and restores it gradually as
+	// requests succeed.
+	RetryModeAdaptive = "adaptive"
+)
+
+// RetryModes returns all valid retry modes.
+func RetryModes() []string {
+	return []string{
+		RetryModeStandard,
+		RetryModeAdaptive,
+	}
+}
+
+// RetryConfig is the retry policy applied to all AWS API clients.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts for a request, including the first.
+	// Zero uses the provider's max_retries.
+	MaxAttempts int
+
+	// Mode is one of RetryModeStandard or RetryModeAdaptive.
+	Mode string
+
+	// MaxBackoff is the maximum delay between attempts. Zero uses the AWS SDK default.
+	MaxBackoff time.Duration
+}
+
+func (c *RetryConfig) adaptive() bool {
+	return c != nil && c.Mode == RetryModeAdaptive
+}
+
+func (c *RetryConfig) validate() error {
+	if c == nil {
+		return nil
+	}
+
+	switch c.Mode {
+	case "", RetryModeStandard, RetryModeAdaptive:
+	default:
+		return fmt.Errorf("unsupported retry mode (%s), expected one of: %s, %s", c.Mode, RetryModeStandard, RetryModeAdaptive)
+	}
+
+	if c.MaxAttempts < 0 {
+		return fmt.Errorf("retry max_attempts (%d) must not be negative", c.MaxAttempts)
+	}
+
+	if c.MaxBackoff < 0 {
+		return fmt.Errorf("retry max_backoff (%s) must not be negative", c.MaxBackoff)
+	}
+
+	return nil
+}
+
+// retryerV1 returns the AWS SDK for Go v1 retryer implementing the policy.
+// maxRetries is used when MaxAttempts is not set.
+func (c *RetryConfig) retryerV1(maxRetries int) client.DefaultRetryer {
+	retryer := client.DefaultRetryer{
+		NumMaxRetries:    maxRetries,
+		MaxRetryDelay:    c.MaxBackoff,
+		MaxThrottleDelay: c.MaxBackoff,
+	}
+
+	if c.MaxAttempts > 0 {
+		retryer.NumMaxRetries = c.MaxAttempts - 1
+	}
+
+	return retryer
+}
+
+// retryerV2 returns the AWS SDK for Go v2 retryer implementing the policy,
+// based on the retryer configured by aws-sdk-go-base.
+func (c *RetryConfig) retryerV2(retryer func() awsv2.Retryer) func() awsv2.Retryer {
+	if c.adaptive() {
+		maxAttempts := c.MaxAttempts
+		if maxAttempts == 0 {
+			maxAttempts = retryer().MaxAttempts()
+		}
+
+		return func() awsv2.Retryer {
+			return retryv2.NewAdaptiveMode(func(o *retryv2.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, func(o *retryv2.StandardOptions) {
+					o.MaxAttempts = maxAttempts
+					if c.MaxBackoff > 0 {
+						o.MaxBackoff = c.MaxBackoff
+					}
+				})
+			})
+		}
+	}
+
+	return func() awsv2.Retryer {
+		r := retryer()
+
+		if c.MaxAttempts > 0 {
+			r = retryv2.AddWithMaxAttempts(r, c.MaxAttempts)
+		}
+
+		if c.MaxBackoff > 0 {
+			r = retryv2.AddWithMaxBackoffDelay(r, c.MaxBackoff)
+		}
+
+		return r
+	}
+}
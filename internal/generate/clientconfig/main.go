@@ -22,9 +22,10 @@ const (
 )
 
 type ServiceDatum struct {
-	SDKVersion        string
-	GoPackage         string
-	ProviderNameUpper string
+	SDKVersion         string
+	GoPackage          string
+	ProviderNameUpper  string
+	SkipClientGenerate bool
 }
 
 type TemplateData struct {
@@ -55,7 +56,7 @@ func main() {
 			continue
 		}
 
-		if l[names.ColExclude] != "" || l[names.ColSkipClientGenerate] != "" {
+		if l[names.ColExclude] != "" {
 			continue
 		}
 
@@ -64,8 +65,9 @@ func main() {
 		}
 
 		s := ServiceDatum{
-			ProviderNameUpper: l[names.ColProviderNameUpper],
-			SDKVersion:        l[names.ColSDKVersion],
+			ProviderNameUpper:  l[names.ColProviderNameUpper],
+			SDKVersion:         l[names.ColSDKVersion],
+			SkipClientGenerate: l[names.ColSkipClientGenerate] != "",
 		}
 
 		if l[names.ColSDKVersion] == "1" {
@@ -133,8 +135,17 @@ import (
 func (c *Config) clientConns(sess *session.Session) *AWSClient {
 	return &AWSClient{
 		{{- range .Services }}
+		{{- if not .SkipClientGenerate }}
 		{{ .ProviderNameUpper }}Conn: {{ .GoPackage }}.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.{{ .ProviderNameUpper }}])})),
 		{{- end }}
+		{{- end }}
 	}
 }
+
+// serviceIDs maps provider service packages to the service IDs of their AWS SDK clients.
+var serviceIDs = map[string]string{
+	{{- range .Services }}
+	names.{{ .ProviderNameUpper }}: {{ .GoPackage }}.ServiceID,
+	{{- end }}
+}
 `
@@ -0,0 +1,136 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	filename = `policy_lint_actions.txt`
+	module   = `github.com/aws/aws-sdk-go`
+)
+
+// prefixOverrides maps API signing names to IAM service prefixes where they differ.
+// Services whose IAM actions are not their API operations, e.g. API Gateway, are mapped to "" and omitted.
+var prefixOverrides = map[string]string{
+	"AWSMobileHubService": "mobilehub",
+	"IoTSecuredTunneling": "iot",
+	"apigateway":          "",
+	"awsssooidc":          "",
+	"awsssoportal":        "",
+	"execute-api":         "",
+	"iot-jobs-data":       "iotjobsdata",
+	"iotdata":             "iot",
+	"ioteventsdata":       "iotevents",
+	"monitoring":          "cloudwatch",
+	"mturk-requester":     "mechanicalturk",
+	"participant.connect": "",
+	"tagging":             "tag",
+}
+
+type apiModel struct {
+	Metadata struct {
+		EndpointPrefix string `json:"endpointPrefix"`
+		SigningName    string `json:"signingName"`
+	} `json:"metadata"`
+	Operations map[string]struct{} `json:"operations"`
+}
+
+func main() {
+	fmt.Printf("Generating internal/service/iam/%s\n", filename)
+
+	output, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", module).Output()
+	if err != nil {
+		log.Fatalf("error locating module (%s): %s", module, err)
+	}
+
+	models, err := filepath.Glob(filepath.Join(strings.TrimSpace(string(output)), "models", "apis", "*", "*", "api-2.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	actions := make(map[string]map[string]bool)
+
+	for _, model := range models {
+		b, err := os.ReadFile(model)
+		if err != nil {
+			log.Fatalf("error reading %s: %s", model, err)
+		}
+
+		var api apiModel
+
+		if err := json.Unmarshal(b, &api); err != nil {
+			log.Fatalf("error parsing %s: %s", model, err)
+		}
+
+		// AWS IoT is signed as execute-api.
+		prefix := api.Metadata.SigningName
+		if prefix == "" || prefix == "execute-api" {
+			prefix = api.Metadata.EndpointPrefix
+		}
+		if v, ok := prefixOverrides[prefix]; ok {
+			prefix = v
+		}
+		if prefix == "" {
+			continue
+		}
+
+		if actions[prefix] == nil {
+			actions[prefix] = make(map[string]bool)
+		}
+
+		for operation := range api.Operations {
+			actions[prefix][operation] = true
+		}
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	w := bufio.NewWriter(f)
+
+	fmt.Fprintln(w, "# Generated by internal/generate/iamactions/main.go; DO NOT EDIT.")
+	fmt.Fprintln(w, "# IAM service prefix followed by the service's API operations.")
+
+	prefixes := make([]string, 0, len(actions))
+	for prefix := range actions {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		fmt.Fprintf(w, "%s %s\n", prefix, strings.Join(sortedKeys(actions[prefix]), " "))
+	}
+
+	if err := w.Flush(); err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		log.Fatalf("error closing file (%s): %s", filename, err)
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
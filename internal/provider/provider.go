@@ -276,6 +276,19 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"case_insensitive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether resource tag keys are matched ignoring case.",
+						},
 					},
 				},
 			},
@@ -301,12 +314,53 @@ func Provider() *schema.Provider {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": rateLimitSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Resource tag required across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Values allowed for the resource tag.",
+									},
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+										Description:  "Resource tag key.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression the resource tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retry": retrySchema(),
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -455,7 +509,8 @@ func Provider() *schema.Provider {
 			"aws_ce_cost_category": ce.DataSourceCostCategory(),
 			"aws_ce_tags":          ce.DataSourceTags(),
 
-			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource":  cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resources": cloudcontrol.DataSourceResources(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
 			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
@@ -475,12 +530,14 @@ func Provider() *schema.Provider {
 
 			"aws_cloudtrail_service_account": cloudtrail.DataSourceServiceAccount(),
 
-			"aws_cloudwatch_event_bus":        events.DataSourceBus(),
-			"aws_cloudwatch_event_connection": events.DataSourceConnection(),
-			"aws_cloudwatch_event_source":     events.DataSourceSource(),
+			"aws_cloudwatch_event_bus":          events.DataSourceBus(),
+			"aws_cloudwatch_event_connection":   events.DataSourceConnection(),
+			"aws_cloudwatch_event_pattern_test": events.DataSourcePatternTest(),
+			"aws_cloudwatch_event_source":       events.DataSourceSource(),
 
-			"aws_cloudwatch_log_group":  logs.DataSourceGroup(),
-			"aws_cloudwatch_log_groups": logs.DataSourceGroups(),
+			"aws_cloudwatch_log_filter_pattern_test": logs.DataSourceFilterPatternTest(),
+			"aws_cloudwatch_log_group":               logs.DataSourceGroup(),
+			"aws_cloudwatch_log_groups":              logs.DataSourceGroups(),
 
 			"aws_codeartifact_authorization_token": codeartifact.DataSourceAuthorizationToken(),
 			"aws_codeartifact_repository_endpoint": codeartifact.DataSourceRepositoryEndpoint(),
@@ -603,23 +660,25 @@ func Provider() *schema.Provider {
 
 			"aws_ecrpublic_authorization_token": ecrpublic.DataSourceAuthorizationToken(),
 
-			"aws_ecs_cluster":              ecs.DataSourceCluster(),
-			"aws_ecs_container_definition": ecs.DataSourceContainerDefinition(),
-			"aws_ecs_service":              ecs.DataSourceService(),
-			"aws_ecs_task_definition":      ecs.DataSourceTaskDefinition(),
+			"aws_ecs_cluster":               ecs.DataSourceCluster(),
+			"aws_ecs_container_definition":  ecs.DataSourceContainerDefinition(),
+			"aws_ecs_container_definitions": ecs.DataSourceContainerDefinitions(),
+			"aws_ecs_service":               ecs.DataSourceService(),
+			"aws_ecs_task_definition":       ecs.DataSourceTaskDefinition(),
 
 			"aws_efs_access_point":  efs.DataSourceAccessPoint(),
 			"aws_efs_access_points": efs.DataSourceAccessPoints(),
 			"aws_efs_file_system":   efs.DataSourceFileSystem(),
 			"aws_efs_mount_target":  efs.DataSourceMountTarget(),
 
-			"aws_eks_addon":         eks.DataSourceAddon(),
-			"aws_eks_addon_version": eks.DataSourceAddonVersion(),
-			"aws_eks_cluster":       eks.DataSourceCluster(),
-			"aws_eks_clusters":      eks.DataSourceClusters(),
-			"aws_eks_cluster_auth":  eks.DataSourceClusterAuth(),
-			"aws_eks_node_group":    eks.DataSourceNodeGroup(),
-			"aws_eks_node_groups":   eks.DataSourceNodeGroups(),
+			"aws_eks_addon":              eks.DataSourceAddon(),
+			"aws_eks_addon_version":      eks.DataSourceAddonVersion(),
+			"aws_eks_cluster":            eks.DataSourceCluster(),
+			"aws_eks_clusters":           eks.DataSourceClusters(),
+			"aws_eks_cluster_auth":       eks.DataSourceClusterAuth(),
+			"aws_eks_cluster_kubeconfig": eks.DataSourceClusterKubeconfig(),
+			"aws_eks_node_group":         eks.DataSourceNodeGroup(),
+			"aws_eks_node_groups":        eks.DataSourceNodeGroups(),
 
 			"aws_elasticache_cluster":           elasticache.DataSourceCluster(),
 			"aws_elasticache_replication_group": elasticache.DataSourceReplicationGroup(),
@@ -846,8 +905,9 @@ func Provider() *schema.Provider {
 			"aws_servicequotas_service":       servicequotas.DataSourceService(),
 			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
 
-			"aws_sfn_activity":      sfn.DataSourceActivity(),
-			"aws_sfn_state_machine": sfn.DataSourceStateMachine(),
+			"aws_sfn_activity":              sfn.DataSourceActivity(),
+			"aws_sfn_definition_validation": sfn.DataSourceDefinitionValidation(),
+			"aws_sfn_state_machine":         sfn.DataSourceStateMachine(),
 
 			"aws_signer_signing_job":     signer.DataSourceSigningJob(),
 			"aws_signer_signing_profile": signer.DataSourceSigningProfile(),
@@ -1246,6 +1306,7 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_items":                   dynamodb.ResourceTableItems(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
 			"aws_ami":                                              ec2.ResourceAMI(),
@@ -1523,6 +1584,7 @@ func Provider() *schema.Provider {
 			"aws_iam_openid_connect_provider":     iam.ResourceOpenIDConnectProvider(),
 			"aws_iam_policy":                      iam.ResourcePolicy(),
 			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
+			"aws_iam_policy_set":                  iam.ResourcePolicySet(),
 			"aws_iam_role":                        iam.ResourceRole(),
 			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
 			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
@@ -1595,9 +1657,11 @@ func Provider() *schema.Provider {
 			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
 
-			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
-			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
-			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
+			"aws_lakeformation_data_lake_settings":    lakeformation.ResourceDataLakeSettings(),
+			"aws_lakeformation_permissions":           lakeformation.ResourcePermissions(),
+			"aws_lakeformation_principal_permissions": lakeformation.ResourcePrincipalPermissions(),
+			"aws_lakeformation_resource":              lakeformation.ResourceResource(),
+			"aws_lakeformation_resource_permissions":  lakeformation.ResourceResourcePermissions(),
 
 			"aws_lambda_alias":                          lambda.ResourceAlias(),
 			"aws_lambda_code_signing_config":            lambda.ResourceCodeSigningConfig(),
@@ -1783,6 +1847,7 @@ func Provider() *schema.Provider {
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
+			"aws_route53_zone_records":                  route53.ResourceZoneRecords(),
 
 			"aws_route53domains_registered_domain": route53domains.ResourceRegisteredDomain(),
 
@@ -1828,6 +1893,7 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory":                                   s3.ResourceDirectory(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -2064,7 +2130,10 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     d.Get("max_retries").(int),
 		Profile:                        d.Get("profile").(string),
+		RateLimit:                      expandProviderRateLimit(d.Get("rate_limit").([]interface{})),
 		Region:                         d.Get("region").(string),
+		RequiredTagsConfig:             expandProviderRequiredTags(d.Get("required_tags").([]interface{})),
+		Retry:                          expandProviderRetry(d.Get("retry").([]interface{})),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool) || d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
@@ -2124,6 +2193,10 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		}
 	}
 
+	if config.DefaultTagsConfig != nil {
+		config.DefaultTagsConfig.IgnoreConfig = config.IgnoreTagsConfig.DefaultTagsIgnoreConfig()
+	}
+
 	if v, null, _ := nullable.Bool(d.Get("skip_metadata_api_check").(string)).Value(); !null {
 		if v {
 			config.EC2MetadataServiceEnableState = imds.ClientDisabled
@@ -2287,6 +2360,90 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to limit the rate of requests to each AWS service endpoint.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The number of requests that can be sent at once before the rate limit applies. Defaults to `requests_per_second`.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The maximum sustained rate of requests to each service endpoint.",
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"service": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Rate limits for individual services, overriding the default rate limit.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"burst": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "The number of requests that can be sent at once before the rate limit applies. Defaults to `requests_per_second`.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The service, using the same names as the `endpoints` block.",
+								ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+							},
+							"requests_per_second": {
+								Type:         schema.TypeFloat,
+								Required:     true,
+								Description:  "The maximum sustained rate of requests to each endpoint of the service.",
+								ValidateFunc: validation.FloatAtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to retry failed AWS API requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of attempts for an AWS API request, including the first. Overrides `max_retries`.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum delay between attempts. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validRetryMaxBackoff,
+				},
+				"mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      conns.RetryModeStandard,
+					Description:  "The retry mode. Valid values are `standard` and `adaptive`.",
+					ValidateFunc: validation.StringInSlice(conns.RetryModes(), false),
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 	assumeRole := awsbase.AssumeRole{}
 
@@ -2433,9 +2590,138 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
+	if v, ok := m["case_insensitive"].(bool); ok {
+		ignoreConfig.CaseInsensitive = v
+	}
+
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, v := range v.List() {
+			expr := v.(string)
+
+			if ignoreConfig.CaseInsensitive {
+				expr = "(?i)" + expr
+			}
+
+			// Already validated by the schema.
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexp.MustCompile(expr))
+		}
+	}
+
 	return ignoreConfig
 }
 
+func expandProviderRequiredTags(l []interface{}) *tftags.RequiredConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tag"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			requiredTag := &tftags.RequiredTag{}
+
+			if v, ok := tfMap["key"].(string); ok {
+				requiredTag.Key = v
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok {
+				for _, v := range v.List() {
+					requiredTag.AllowedValues = append(requiredTag.AllowedValues, v.(string))
+				}
+			}
+
+			// Already validated by the schema.
+			if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+				requiredTag.ValueRegex = regexp.MustCompile(v)
+			}
+
+			requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+		}
+	}
+
+	return requiredConfig
+}
+
+func expandProviderRateLimit(l []interface{}) *conns.RateLimitConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	rateLimitConfig := &conns.RateLimitConfig{}
+	m := l[0].(map[string]interface{})
+
+	rateLimitConfig.RateLimit = expandRateLimit(m)
+
+	if v, ok := m["service"].(*schema.Set); ok && v.Len() > 0 {
+		rateLimitConfig.Services = make(map[string]conns.RateLimit)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			service, err := names.ProviderPackageForAlias(tfMap["name"].(string))
+
+			if err != nil {
+				log.Printf("[WARN] rate_limit: %s", err)
+				continue
+			}
+
+			rateLimitConfig.Services[service] = expandRateLimit(tfMap)
+		}
+	}
+
+	return rateLimitConfig
+}
+
+func expandRateLimit(m map[string]interface{}) conns.RateLimit {
+	rateLimit := conns.RateLimit{}
+
+	if v, ok := m["burst"].(int); ok {
+		rateLimit.Burst = v
+	}
+
+	if v, ok := m["requests_per_second"].(float64); ok {
+		rateLimit.RequestsPerSecond = v
+	}
+
+	return rateLimit
+}
+
+func expandProviderRetry(l []interface{}) *conns.RetryConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	retryConfig := &conns.RetryConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["max_attempts"].(int); ok {
+		retryConfig.MaxAttempts = v
+	}
+
+	if v, ok := m["max_backoff"].(string); ok && v != "" {
+		maxBackoff, _ := time.ParseDuration(v)
+		retryConfig.MaxBackoff = maxBackoff
+	}
+
+	if v, ok := m["mode"].(string); ok {
+		retryConfig.Mode = v
+	}
+
+	return retryConfig
+}
+
 func expandEndpoints(endpointsSetList []interface{}, out map[string]string) error {
 	for _, endpointsSetI := range endpointsSetList {
 		endpoints := endpointsSetI.(map[string]interface{})
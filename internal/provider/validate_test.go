@@ -66,3 +66,41 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidRetryMaxBackoff(t *testing.T) {
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "1",
+			expectedErr: regexp.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "0s",
+			expectedErr: regexp.MustCompile(`must be positive`),
+		},
+		{
+			val: "500ms",
+		},
+		{
+			val: "1m",
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validRetryMaxBackoff(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
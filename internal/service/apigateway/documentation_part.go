@@ -72,6 +72,7 @@ func ResourceDocumentationPart() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceDocumentationPartCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayConn
 
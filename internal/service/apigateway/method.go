@@ -105,6 +105,7 @@ func ResourceMethod() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceMethodCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayConn
 
@@ -83,6 +83,7 @@ func ResourceMethodResponse() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceMethodResponseCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayConn
 
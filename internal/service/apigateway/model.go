@@ -78,6 +78,7 @@ func ResourceModel() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceModelCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayConn
 	log.Printf("[DEBUG] Creating API Gateway Model")
@@ -59,6 +59,7 @@ func ResourceRequestValidator() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceRequestValidatorCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayConn
 
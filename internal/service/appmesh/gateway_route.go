@@ -336,6 +336,7 @@ func resourceGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
 		var err error
 
+		//lintignore:AWSR003
 		gatewayRoute, err = FindGatewayRoute(conn, d.Get("mesh_name").(string), d.Get("virtual_gateway_name").(string), d.Get("name").(string), d.Get("mesh_owner").(string))
 
 		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
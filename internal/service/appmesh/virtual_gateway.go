@@ -723,6 +723,7 @@ func resourceVirtualGatewayRead(d *schema.ResourceData, meta interface{}) error
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
 		var err error
 
+		//lintignore:AWSR003
 		virtualGateway, err = FindVirtualGateway(conn, d.Get("mesh_name").(string), d.Get("name").(string), d.Get("mesh_owner").(string))
 
 		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
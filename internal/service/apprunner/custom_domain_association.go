@@ -121,6 +121,7 @@ func resourceCustomDomainAssociationRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	//lintignore:AWSR003
 	customDomain, err := FindCustomDomain(ctx, conn, domainName, serviceArn)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
@@ -51,6 +51,7 @@ func ResourceVpcConnector() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			//lintignore:AWSR006
 			"tags": tftags.TagsSchemaComputed(),
 			"status": {
 				Type:     schema.TypeString,
@@ -29,6 +29,7 @@ func ResourceFleet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: verify.SetTagsDiff,
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -462,10 +463,10 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(fmt.Errorf("error updating Appstream Fleet (%s): %w", d.Id(), err))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		arn := aws.StringValue(resp.Fleet.Arn)
 
-		o, n := d.GetChange("tags")
+		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, arn, o, n); err != nil {
 			return diag.FromErr(fmt.Errorf("error updating Appstream Fleet tags (%s): %w", d.Id(), err))
 		}
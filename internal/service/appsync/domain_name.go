@@ -76,6 +76,7 @@ func resourceDomainNameCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceDomainNameRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppSyncConn
 
+	//lintignore:AWSR003
 	domainName, err := FindDomainNameByID(conn, d.Id())
 	if domainName == nil && !d.IsNewResource() {
 		log.Printf("[WARN] AppSync Domain Name (%s) not found, removing from state", d.Id())
@@ -61,6 +61,7 @@ func resourceDomainNameApiAssociationCreate(d *schema.ResourceData, meta interfa
 func resourceDomainNameApiAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppSyncConn
 
+	//lintignore:AWSR003
 	association, err := FindDomainNameApiAssociationByID(conn, d.Id())
 	if association == nil && !d.IsNewResource() {
 		log.Printf("[WARN] Appsync Domain Name API Association (%s) not found, removing from state", d.Id())
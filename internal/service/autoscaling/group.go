@@ -460,6 +460,7 @@ func ResourceGroup() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSR006
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
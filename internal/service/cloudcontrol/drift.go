@@ -0,0 +1,162 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+)
+
+// desiredStateWithDrift returns the desired state JSON updated with any drift in the
+// resource's current properties JSON, as described by the CloudFormation resource schema.
+// Only properties present in the desired state are compared. Write-only and read-only
+// properties keep their desired values as they are not returned by, or cannot be set
+// through, the Cloud Control API. Properties missing from the current properties keep
+// their desired values if the values equal the schema default, otherwise they are removed.
+// Arrays of properties with insertionOrder false are compared ignoring order.
+// If there is no drift the desired state is returned unchanged.
+func desiredStateWithDrift(cfResource *cfschema.Resource, desiredState, properties string) (string, error) {
+	var desired, current map[string]interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal([]byte(properties), &current); err != nil {
+		return "", err
+	}
+
+	drifted := objectWithDrift(cfResource, nil, cfResource.Properties, desired, current)
+
+	if valuesEqual(nil, desired, drifted) {
+		return desiredState, nil
+	}
+
+	b, err := json.Marshal(drifted)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func objectWithDrift(cfResource *cfschema.Resource, path []string, cfProperties map[string]*cfschema.Property, desired, current map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(desired))
+
+	for k, desiredValue := range desired {
+		propertyPath := append(append([]string{}, path...), k)
+		cfProperty := cfProperties[k]
+
+		if cfResource.WriteOnlyProperties.ContainsPath(propertyPath) || cfResource.ReadOnlyProperties.ContainsPath(propertyPath) {
+			result[k] = desiredValue
+			continue
+		}
+
+		currentValue, ok := current[k]
+
+		if !ok {
+			if cfProperty != nil && cfProperty.Default != nil && valuesEqual(nil, cfProperty.Default, desiredValue) {
+				result[k] = desiredValue
+			}
+
+			continue
+		}
+
+		desiredObject, desiredOK := desiredValue.(map[string]interface{})
+		currentObject, currentOK := currentValue.(map[string]interface{})
+
+		if desiredOK && currentOK {
+			var nested map[string]*cfschema.Property
+
+			if cfProperty != nil {
+				nested = cfProperty.Properties
+			}
+
+			result[k] = objectWithDrift(cfResource, propertyPath, nested, desiredObject, currentObject)
+
+			continue
+		}
+
+		if valuesEqual(cfProperty, desiredValue, currentValue) {
+			result[k] = desiredValue
+		} else {
+			result[k] = currentValue
+		}
+	}
+
+	return result
+}
+
+// valuesEqual returns whether two JSON values are equal.
+// Scalars are compared by their string representation as some resource handlers return, for example, numbers as strings.
+func valuesEqual(cfProperty *cfschema.Property, v1, v2 interface{}) bool {
+	switch v1 := v1.(type) {
+	case map[string]interface{}:
+		v2, ok := v2.(map[string]interface{})
+
+		if !ok || len(v1) != len(v2) {
+			return false
+		}
+
+		for k, e1 := range v1 {
+			e2, ok := v2[k]
+
+			if !ok || !valuesEqual(nil, e1, e2) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		v2, ok := v2.([]interface{})
+
+		if !ok || len(v1) != len(v2) {
+			return false
+		}
+
+		if cfProperty == nil || cfProperty.InsertionOrder == nil || *cfProperty.InsertionOrder {
+			for i := range v1 {
+				if !valuesEqual(nil, v1[i], v2[i]) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		return unorderedValuesEqual(v1, v2)
+	case nil:
+		return v2 == nil
+	default:
+		switch v2.(type) {
+		case map[string]interface{}, []interface{}, nil:
+			return false
+		}
+
+		return fmt.Sprint(v1) == fmt.Sprint(v2)
+	}
+}
+
+// unorderedValuesEqual returns whether two JSON arrays of the same length have equal elements in any order.
+func unorderedValuesEqual(a1, a2 []interface{}) bool {
+	matched := make([]bool, len(a2))
+
+	for _, e1 := range a1 {
+		found := false
+
+		for i, e2 := range a2 {
+			if !matched[i] && valuesEqual(nil, e1, e2) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
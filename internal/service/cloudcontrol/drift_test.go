@@ -0,0 +1,126 @@
+package cloudcontrol
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+)
+
+func TestDesiredStateWithDrift(t *testing.T) {
+	cfResource := &cfschema.Resource{
+		Properties: map[string]*cfschema.Property{
+			"Name":     {},
+			"Password": {},
+			"RetentionInDays": {
+				Default: float64(7),
+			},
+			"Subnets": {
+				InsertionOrder: aws.Bool(false),
+			},
+			"Config": {
+				Properties: map[string]*cfschema.Property{
+					"Enabled": {},
+					"Secret":  {},
+				},
+			},
+		},
+		ReadOnlyProperties: cfschema.PropertyJsonPointers{
+			"/properties/Arn",
+		},
+		WriteOnlyProperties: cfschema.PropertyJsonPointers{
+			"/properties/Password",
+			"/properties/Config/Secret",
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		desiredState string
+		properties   string
+		want         string
+	}{
+		{
+			name:         "no drift",
+			desiredState: `{"Name": "test"}`,
+			properties:   `{"Name":"test","Arn":"arn"}`,
+			want:         `{"Name": "test"}`,
+		},
+		{
+			name:         "value drift",
+			desiredState: `{"Name":"test"}`,
+			properties:   `{"Name":"changed"}`,
+			want:         `{"Name":"changed"}`,
+		},
+		{
+			name:         "value removed",
+			desiredState: `{"Name":"test"}`,
+			properties:   `{}`,
+			want:         `{}`,
+		},
+		{
+			name:         "unconfigured properties",
+			desiredState: `{"Name":"test"}`,
+			properties:   `{"Name":"test","Other":"value"}`,
+			want:         `{"Name":"test"}`,
+		},
+		{
+			name:         "write-only properties",
+			desiredState: `{"Name":"test","Password":"secret","Config":{"Enabled":true,"Secret":"secret"}}`,
+			properties:   `{"Name":"test","Config":{"Enabled":true}}`,
+			want:         `{"Name":"test","Password":"secret","Config":{"Enabled":true,"Secret":"secret"}}`,
+		},
+		{
+			name:         "nested drift",
+			desiredState: `{"Name":"test","Password":"secret","Config":{"Enabled":true,"Secret":"secret"}}`,
+			properties:   `{"Name":"test","Config":{"Enabled":false}}`,
+			want:         `{"Config":{"Enabled":false,"Secret":"secret"},"Name":"test","Password":"secret"}`,
+		},
+		{
+			name:         "default value",
+			desiredState: `{"Name":"test","RetentionInDays":7}`,
+			properties:   `{"Name":"test"}`,
+			want:         `{"Name":"test","RetentionInDays":7}`,
+		},
+		{
+			name:         "non-default value removed",
+			desiredState: `{"Name":"test","RetentionInDays":14}`,
+			properties:   `{"Name":"test"}`,
+			want:         `{"Name":"test"}`,
+		},
+		{
+			name:         "scalar string representation",
+			desiredState: `{"Name":"test","RetentionInDays":14}`,
+			properties:   `{"Name":"test","RetentionInDays":"14"}`,
+			want:         `{"Name":"test","RetentionInDays":14}`,
+		},
+		{
+			name:         "unordered array",
+			desiredState: `{"Subnets":["a","b"]}`,
+			properties:   `{"Subnets":["b","a"]}`,
+			want:         `{"Subnets":["a","b"]}`,
+		},
+		{
+			name:         "unordered array drift",
+			desiredState: `{"Subnets":["a","b"]}`,
+			properties:   `{"Subnets":["b","c"]}`,
+			want:         `{"Subnets":["b","c"]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := desiredStateWithDrift(cfResource, testCase.desiredState, testCase.properties)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
@@ -143,6 +143,23 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("properties", resourceDescription.Properties)
 
+	// Reflect any drift in the current properties in desired_state so that it is planned for update.
+	if desiredState, resourceSchema := d.Get("desired_state").(string), d.Get("schema").(string); !d.IsNewResource() && desiredState != "" && resourceSchema != "" {
+		cfResource, err := expandedResourceSchema(resourceSchema)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		desiredState, err := desiredStateWithDrift(cfResource, desiredState, aws.StringValue(resourceDescription.Properties))
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error comparing Cloud Control API Resource (%s) desired_state and properties: %w", d.Id(), err))
+		}
+
+		d.Set("desired_state", desiredState)
+	}
+
 	return nil
 }
 
@@ -322,6 +339,34 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 	return nil
 }
 
+// expandedResourceSchema returns the CloudFormation resource from the resource schema JSON,
+// with all property references resolved.
+func expandedResourceSchema(resourceSchema string) (*cfschema.Resource, error) {
+	resourceSchema, err := cfschema.Sanitize(resourceSchema)
+
+	if err != nil {
+		return nil, fmt.Errorf("error sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(resourceSchema)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	cfResource, err := cfResourceSchema.Resource()
+
+	if err != nil {
+		return nil, fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	if err := cfResource.Expand(); err != nil {
+		return nil, fmt.Errorf("error expanding CloudFormation Resource Schema: %w", err)
+	}
+
+	return cfResource, nil
+}
+
 // patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
 func patchDocument(old, new string) (string, error) {
 	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
@@ -0,0 +1,101 @@
+package cloudcontrol
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	typeName := d.Get("type_name").(string)
+	input := &cloudcontrolapi.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("resource_model"); ok {
+		input.ResourceModel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_version_id"); ok {
+		input.TypeVersionId = aws.String(v.(string))
+	}
+
+	resourceDescriptions, err := FindResources(ctx, conn, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Cloud Control API Resources (%s): %w", typeName, err))
+	}
+
+	var tfList []interface{}
+
+	for _, resourceDescription := range resourceDescriptions {
+		tfList = append(tfList, map[string]interface{}{
+			"identifier": aws.StringValue(resourceDescription.Identifier),
+			"properties": aws.StringValue(resourceDescription.Properties),
+		})
+	}
+
+	d.SetId(typeName)
+
+	if err := d.Set("resources", tfList); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting resources: %w", err))
+	}
+
+	return nil
+}
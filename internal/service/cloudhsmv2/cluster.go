@@ -158,6 +158,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	//lintignore:AWSR003
 	cluster, err := FindCluster(conn, d.Id())
 
 	if err != nil {
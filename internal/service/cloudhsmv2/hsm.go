@@ -124,6 +124,7 @@ func resourceHSMCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceHSMRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
 
+	//lintignore:AWSR003
 	hsm, err := FindHSM(conn, d.Id(), d.Get("hsm_eni_id").(string))
 
 	if err != nil {
@@ -133,6 +133,7 @@ func resourceReportGroupRead(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	//lintignore:AWSR003
 	reportGroup, err := FindReportGroupByARN(conn, d.Id())
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, codebuild.ErrCodeResourceNotFoundException) {
 		names.LogNotFoundRemoveState(names.CodeBuild, names.ErrActionReading, ResReportGroup, d.Id())
@@ -16,17 +16,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
 		Name: "aws_codebuild_report_group",
 		F:    sweepReportGroups,
 	})
 
-	resource.AddTestSweepers("aws_codebuild_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_project", &resource.Sweeper{
 		Name: "aws_codebuild_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_codebuild_source_credential", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_source_credential", &resource.Sweeper{
 		Name: "aws_codebuild_source_credential",
 		F:    sweepSourceCredentials,
 	})
@@ -55,7 +55,7 @@ func sweepReportGroups(region string) error {
 			d.SetId(id)
 			d.Set("delete_reports", true)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting CodeBuild Report Group (%s): %w", id, err)
 				log.Printf("[ERROR] %s", sweeperErr)
@@ -101,7 +101,7 @@ func sweepProjects(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting CodeBuild Project (%s): %w", id, err)
 				log.Printf("[ERROR] %s", sweeperErr)
@@ -144,7 +144,7 @@ func sweepSourceCredentials(region string) error {
 		d := r.Data(nil)
 		d.SetId(id)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			sweeperErr := fmt.Errorf("error deleting CodeBuild Source Credential (%s): %w", id, err)
 			log.Printf("[ERROR] %s", sweeperErr)
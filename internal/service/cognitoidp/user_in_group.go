@@ -75,6 +75,7 @@ func resourceUserInGroupRead(d *schema.ResourceData, meta interface{}) error {
 	userPoolId := d.Get("user_pool_id").(string)
 	username := d.Get("username").(string)
 
+	//lintignore:AWSR003
 	found, err := FindCognitoUserInGroup(conn, groupName, userPoolId, username)
 
 	if err != nil {
@@ -116,6 +116,7 @@ func resourceUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("error parsing Cognito User Pool UI customization ID (%s): %w", d.Id(), err)
 	}
 
+	//lintignore:AWSR003
 	uiCustomization, err := FindCognitoUserPoolUICustomization(conn, userPoolId, clientId)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
@@ -113,7 +113,9 @@ func resourceContactFlowCreate(ctx context.Context, d *schema.ResourceData, meta
 		// Grab an exclusive lock so that we're only reading one contact flow into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(awsMutexConnectContactFlowKey)
+		if err := conns.GlobalMutexKV.LockWithContext(ctx, awsMutexConnectContactFlowKey); err != nil {
+			return diag.FromErr(err)
+		}
 		defer conns.GlobalMutexKV.Unlock(awsMutexConnectContactFlowKey)
 		file, err := resourceContactFlowLoadFileContent(filename)
 		if err != nil {
@@ -230,7 +232,9 @@ func resourceContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta
 			// Grab an exclusive lock so that we're only reading one contact flow into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(awsMutexConnectContactFlowKey)
+			if err := conns.GlobalMutexKV.LockWithContext(ctx, awsMutexConnectContactFlowKey); err != nil {
+				return diag.FromErr(err)
+			}
 			defer conns.GlobalMutexKV.Unlock(awsMutexConnectContactFlowKey)
 			file, err := resourceContactFlowLoadFileContent(filename)
 			if err != nil {
@@ -104,7 +104,9 @@ func resourceContactFlowModuleCreate(ctx context.Context, d *schema.ResourceData
 		// Grab an exclusive lock so that we're only reading one contact flow module into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(awsMutexConnectContactFlowModuleKey)
+		if err := conns.GlobalMutexKV.LockWithContext(ctx, awsMutexConnectContactFlowModuleKey); err != nil {
+			return diag.FromErr(err)
+		}
 		defer conns.GlobalMutexKV.Unlock(awsMutexConnectContactFlowModuleKey)
 		file, err := resourceContactFlowModuleLoadFileContent(filename)
 		if err != nil {
@@ -220,7 +222,9 @@ func resourceContactFlowModuleUpdate(ctx context.Context, d *schema.ResourceData
 			// Grab an exclusive lock so that we're only reading one contact flow module into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(awsMutexConnectContactFlowModuleKey)
+			if err := conns.GlobalMutexKV.LockWithContext(ctx, awsMutexConnectContactFlowModuleKey); err != nil {
+				return diag.FromErr(err)
+			}
 			defer conns.GlobalMutexKV.Unlock(awsMutexConnectContactFlowModuleKey)
 			file, err := resourceContactFlowModuleLoadFileContent(filename)
 			if err != nil {
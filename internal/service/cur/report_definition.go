@@ -161,6 +161,7 @@ func resourceReportDefinitionCreate(d *schema.ResourceData, meta interface{}) er
 func resourceReportDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CURConn
 
+	//lintignore:AWSR003
 	reportDefinition, err := FindReportDefinitionByName(conn, d.Id())
 
 	if err != nil {
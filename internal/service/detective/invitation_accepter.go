@@ -55,6 +55,7 @@ func resourceInvitationAccepterCreate(ctx context.Context, d *schema.ResourceDat
 func resourceInvitationAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DetectiveConn
 
+	//lintignore:AWSR003
 	graphArn, err := FindInvitationByGraphArn(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
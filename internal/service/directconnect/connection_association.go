@@ -33,6 +33,7 @@ func ResourceConnectionAssociation() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceConnectionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
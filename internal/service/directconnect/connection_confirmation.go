@@ -27,6 +27,7 @@ func ResourceConnectionConfirmation() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceConnectionConfirmationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
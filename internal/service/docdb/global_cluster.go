@@ -159,6 +159,7 @@ func resourceGlobalClusterCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DocDBConn
 
+	//lintignore:AWSR003
 	globalCluster, err := FindGlobalClusterById(ctx, conn, d.Id())
 
 	if tfawserr.ErrCodeEquals(err, docdb.ErrCodeGlobalClusterNotFoundFault) {
@@ -52,6 +52,7 @@ func ResourceConditionalForwarder() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceConditionalForwarderCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DSConn
 
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
 		Name: "aws_directory_service_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -58,7 +58,7 @@ func sweepDirectories(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting Directory Service Directory (%s): %w", id, err)
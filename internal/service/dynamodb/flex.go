@@ -2,10 +2,16 @@ package dynamodb
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 )
 
@@ -55,3 +61,293 @@ func flattenTableItemAttributes(attrs map[string]*dynamodb.AttributeValue) (stri
 
 	return rawBuffer.String(), nil
 }
+
+// ExpandTableItems decodes the items of a JSON array or of JSON Lines, one item per line.
+// Items are in DynamoDB JSON, e.g. {"id": {"S": "a"}}, or if plain is true, in plain JSON, e.g. {"id": "a"}.
+func ExpandTableItems(input string, plain bool) ([]map[string]*dynamodb.AttributeValue, error) {
+	var raws []json.RawMessage
+
+	if trimmed := strings.TrimSpace(input); strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal([]byte(trimmed), &raws); err != nil {
+			return nil, fmt.Errorf("decoding items: %w", err)
+		}
+	} else {
+		dec := json.NewDecoder(strings.NewReader(input))
+
+		for dec.More() {
+			var raw json.RawMessage
+
+			if err := dec.Decode(&raw); err != nil {
+				return nil, fmt.Errorf("decoding item %d: %w", len(raws)+1, err)
+			}
+
+			raws = append(raws, raw)
+		}
+	}
+
+	items := make([]map[string]*dynamodb.AttributeValue, 0, len(raws))
+
+	for i, raw := range raws {
+		var item map[string]*dynamodb.AttributeValue
+		var err error
+
+		if plain {
+			item, err = expandTableItemPlainAttributes(raw)
+		} else {
+			item, err = ExpandTableItemAttributes(string(raw))
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding item %d: %w", i+1, err)
+		}
+
+		if item == nil {
+			return nil, fmt.Errorf("decoding item %d: item must be an object", i+1)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func expandTableItemPlainAttributes(raw json.RawMessage) (map[string]*dynamodb.AttributeValue, error) {
+	var m map[string]interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	// Keep the precision of numbers.
+	dec.UseNumber()
+
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		return nil, nil
+	}
+
+	attributes := make(map[string]*dynamodb.AttributeValue, len(m))
+
+	for k, v := range m {
+		attributes[k] = expandTableItemPlainValue(v)
+	}
+
+	return attributes, nil
+}
+
+func expandTableItemPlainValue(v interface{}) *dynamodb.AttributeValue {
+	switch v := v.(type) {
+	case bool:
+		return &dynamodb.AttributeValue{BOOL: aws.Bool(v)}
+	case json.Number:
+		return &dynamodb.AttributeValue{N: aws.String(v.String())}
+	case string:
+		return &dynamodb.AttributeValue{S: aws.String(v)}
+	case []interface{}:
+		l := make([]*dynamodb.AttributeValue, 0, len(v))
+
+		for _, e := range v {
+			l = append(l, expandTableItemPlainValue(e))
+		}
+
+		return &dynamodb.AttributeValue{L: l}
+	case map[string]interface{}:
+		m := make(map[string]*dynamodb.AttributeValue, len(v))
+
+		for k, e := range v {
+			m[k] = expandTableItemPlainValue(e)
+		}
+
+		return &dynamodb.AttributeValue{M: m}
+	default:
+		return &dynamodb.AttributeValue{NULL: aws.Bool(true)}
+	}
+}
+
+// TableItemKeyID returns the DynamoDB JSON of the key of the item, e.g. {"id":{"S":"a"}}.
+// Key attributes must be strings, numbers or binary. Numbers are in canonical form, see canonicalTableItemNumber.
+func TableItemKeyID(item map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (string, error) {
+	key := map[string]map[string]string{}
+
+	for _, name := range []string{hashKey, rangeKey} {
+		if name == "" {
+			continue
+		}
+
+		v, ok := item[name]
+
+		switch {
+		case !ok || v == nil:
+			return "", fmt.Errorf("item has no %q key attribute", name)
+		case v.S != nil:
+			key[name] = map[string]string{dynamodb.ScalarAttributeTypeS: aws.StringValue(v.S)}
+		case v.N != nil:
+			key[name] = map[string]string{dynamodb.ScalarAttributeTypeN: canonicalTableItemNumber(aws.StringValue(v.N))}
+		case v.B != nil:
+			key[name] = map[string]string{dynamodb.ScalarAttributeTypeB: base64.StdEncoding.EncodeToString(v.B)}
+		default:
+			return "", fmt.Errorf("key attribute %q must be a string, number or binary", name)
+		}
+	}
+
+	b, err := json.Marshal(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// TableItemHash returns the SHA-256 hash of the item. The order of the elements of sets and the formatting
+// of numbers don't change the hash.
+func TableItemHash(item map[string]*dynamodb.AttributeValue) (string, error) {
+	b, err := json.Marshal(canonicalTableItemValue(&dynamodb.AttributeValue{M: item}))
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(b)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// canonicalTableItemValue returns the value as a type descriptor and the value, with sorted sets and canonical numbers.
+func canonicalTableItemValue(v *dynamodb.AttributeValue) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	sorted := func(l []*string) []string {
+		s := aws.StringValueSlice(l)
+		sort.Strings(s)
+		return s
+	}
+
+	switch {
+	case v.B != nil:
+		return map[string]interface{}{dynamodb.ScalarAttributeTypeB: v.B}
+	case v.BOOL != nil:
+		return map[string]interface{}{"BOOL": aws.BoolValue(v.BOOL)}
+	case v.BS != nil:
+		s := make([]string, 0, len(v.BS))
+
+		for _, e := range v.BS {
+			s = append(s, base64.StdEncoding.EncodeToString(e))
+		}
+
+		sort.Strings(s)
+
+		return map[string]interface{}{"BS": s}
+	case v.L != nil:
+		l := make([]interface{}, 0, len(v.L))
+
+		for _, e := range v.L {
+			l = append(l, canonicalTableItemValue(e))
+		}
+
+		return map[string]interface{}{"L": l}
+	case v.M != nil:
+		m := make(map[string]interface{}, len(v.M))
+
+		for k, e := range v.M {
+			m[k] = canonicalTableItemValue(e)
+		}
+
+		return map[string]interface{}{"M": m}
+	case v.N != nil:
+		return map[string]interface{}{dynamodb.ScalarAttributeTypeN: canonicalTableItemNumber(aws.StringValue(v.N))}
+	case v.NS != nil:
+		s := make([]string, 0, len(v.NS))
+
+		for _, e := range v.NS {
+			s = append(s, canonicalTableItemNumber(aws.StringValue(e)))
+		}
+
+		sort.Strings(s)
+
+		return map[string]interface{}{"NS": s}
+	case v.NULL != nil:
+		return map[string]interface{}{"NULL": aws.BoolValue(v.NULL)}
+	case v.S != nil:
+		return map[string]interface{}{dynamodb.ScalarAttributeTypeS: aws.StringValue(v.S)}
+	case v.SS != nil:
+		return map[string]interface{}{"SS": sorted(v.SS)}
+	}
+
+	return nil
+}
+
+// canonicalTableItemNumber returns a DynamoDB number in canonical decimal form, as DynamoDB returns it:
+// 1.50 is 1.5 and 1e3 is 1000. Strings that aren't numbers are returned unchanged.
+func canonicalTableItemNumber(n string) string {
+	s := strings.TrimSpace(n)
+	negative := false
+
+	if strings.HasPrefix(s, "-") {
+		negative = true
+		s = s[1:]
+	} else {
+		s = strings.TrimPrefix(s, "+")
+	}
+
+	mantissa, exponent := s, 0
+
+	if i := strings.IndexAny(s, "eE"); i != -1 {
+		e, err := strconv.Atoi(s[i+1:])
+
+		// DynamoDB numbers are between 1e-130 and 1e126.
+		if err != nil || e < -1000 || e > 1000 {
+			return n
+		}
+
+		mantissa, exponent = s[:i], e
+	}
+
+	intPart, fracPart := mantissa, ""
+
+	if i := strings.IndexByte(mantissa, '.'); i != -1 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+
+	digits := intPart + fracPart
+
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return n
+	}
+
+	// The number is digits * 10^exponent.
+	exponent -= len(fracPart)
+	digits = strings.TrimLeft(digits, "0")
+
+	if digits == "" {
+		return "0"
+	}
+
+	trimmed := strings.TrimRight(digits, "0")
+	exponent += len(digits) - len(trimmed)
+	digits = trimmed
+
+	var b strings.Builder
+
+	if negative {
+		b.WriteByte('-')
+	}
+
+	switch {
+	case exponent >= 0:
+		b.WriteString(digits)
+		b.WriteString(strings.Repeat("0", exponent))
+	case -exponent < len(digits):
+		b.WriteString(digits[:len(digits)+exponent])
+		b.WriteByte('.')
+		b.WriteString(digits[len(digits)+exponent:])
+	default:
+		b.WriteString("0.")
+		b.WriteString(strings.Repeat("0", -exponent-len(digits)))
+		b.WriteString(digits)
+	}
+
+	return b.String()
+}
@@ -80,6 +80,7 @@ func resourceKinesisStreamingDestinationRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	//lintignore:AWSR003
 	output, err := FindDynamoDBKinesisDataStreamDestination(ctx, conn, streamArn, tableName)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
@@ -0,0 +1,649 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	TableItemsFormatDynamoDB = "dynamodb"
+	TableItemsFormatJSON     = "json"
+
+	tableItemsBatchGetMaxKeys       = 100
+	tableItemsBatchWriteMaxRequests = 25
+	tableItemsRetryMaxDelay         = 20 * time.Second
+)
+
+func TableItemsFormat_Values() []string {
+	return []string{
+		TableItemsFormatDynamoDB,
+		TableItemsFormatJSON,
+	}
+}
+
+func ResourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTableItemsCreate,
+		ReadContext:   resourceTableItemsRead,
+		UpdateContext: resourceTableItemsUpdate,
+		DeleteContext: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"create_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      TableItemsFormatDynamoDB,
+				ValidateFunc: validation.StringInSlice(TableItemsFormat_Values(), false),
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"item_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"items": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", "items_file"},
+			},
+			"items_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", "items_file"},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	concurrency := d.Get("max_concurrency").(int)
+
+	items, err := tableItemsByKeyID(d, hashKey, rangeKey)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keyIDs := tableItemsKeyIDs(items)
+	existing, err := batchGetTableItems(ctx, conn, tableName, keyIDs, hashKey, rangeKey, concurrency)
+
+	if err != nil {
+		return diag.Errorf("error reading DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	var requests []*dynamodb.WriteRequest
+
+	if d.Get("create_only").(bool) {
+		// Seed the items that don't exist.
+		for _, keyID := range keyIDs {
+			if _, ok := existing[keyID]; !ok {
+				requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: items[keyID]}})
+			}
+		}
+
+		log.Printf("[DEBUG] Creating %d of %d DynamoDB Table (%s) items", len(requests), len(items), tableName)
+	} else {
+		// Explode if items exist. We didn't create them.
+		if len(existing) > 0 {
+			return diag.Errorf("error creating DynamoDB Table (%s) items: items already exist: %s", tableName, tableItemsKeyIDsString(tableItemsKeyIDs(existing)))
+		}
+
+		for _, keyID := range keyIDs {
+			requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: items[keyID]}})
+		}
+
+		log.Printf("[DEBUG] Creating %d DynamoDB Table (%s) items", len(requests), tableName)
+	}
+
+	hashes, err := tableItemsHashes(items)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Every item is recorded before writing, so that items that were written are deleted on destroy
+	// even if not all of them were written. Deleting an item that doesn't exist succeeds.
+	d.SetId(tableName)
+	d.Set("item_hashes", hashes)
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, concurrency); err != nil {
+		return diag.Errorf("error creating DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	return resourceTableItemsRead(ctx, d, meta)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	hashes := d.Get("item_hashes").(map[string]interface{})
+
+	// Items are seeded once, changes outside of Terraform are kept.
+	if d.Get("create_only").(bool) {
+		d.Set("item_count", len(hashes))
+
+		return nil
+	}
+
+	keyIDs := make([]string, 0, len(hashes))
+
+	for keyID := range hashes {
+		keyIDs = append(keyIDs, keyID)
+	}
+
+	sort.Strings(keyIDs)
+
+	items, err := batchGetTableItems(ctx, conn, tableName, keyIDs, hashKey, rangeKey, d.Get("max_concurrency").(int))
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing items from state", tableName)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	// Items that were deleted or changed outside of Terraform show as differences to the configuration.
+	newHashes, err := tableItemsHashes(items)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("item_count", len(newHashes))
+	d.Set("item_hashes", newHashes)
+
+	return nil
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	concurrency := d.Get("max_concurrency").(int)
+
+	if d.HasChange("item_hashes") {
+		o, n := d.GetChange("item_hashes")
+		oldHashes, newHashes := o.(map[string]interface{}), n.(map[string]interface{})
+
+		items, err := tableItemsByKeyID(d, hashKey, rangeKey)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var requests []*dynamodb.WriteRequest
+
+		if d.Get("create_only").(bool) {
+			// Seed the items that are new to the configuration and don't exist.
+			var keyIDs []string
+
+			for _, keyID := range tableItemsKeyIDs(items) {
+				if _, ok := oldHashes[keyID]; !ok {
+					keyIDs = append(keyIDs, keyID)
+				}
+			}
+
+			existing, err := batchGetTableItems(ctx, conn, tableName, keyIDs, hashKey, rangeKey, concurrency)
+
+			if err != nil {
+				return diag.Errorf("error reading DynamoDB Table (%s) items: %s", tableName, err)
+			}
+
+			for _, keyID := range keyIDs {
+				if _, ok := existing[keyID]; !ok {
+					requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: items[keyID]}})
+				}
+			}
+		} else {
+			for _, keyID := range tableItemsKeyIDs(items) {
+				if oldHashes[keyID] != newHashes[keyID] {
+					requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: items[keyID]}})
+				}
+			}
+
+			deleteRequests, err := tableItemsDeleteRequests(oldHashes, newHashes)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			requests = append(requests, deleteRequests...)
+		}
+
+		log.Printf("[DEBUG] Updating DynamoDB Table (%s) items: %d writes", tableName, len(requests))
+
+		if err := batchWriteTableItems(ctx, conn, tableName, requests, concurrency); err != nil {
+			// Don't record the planned hashes, as not all of the changes were written.
+			d.Set("item_hashes", tableItemsFailedUpdateHashes(oldHashes, newHashes, d.Get("create_only").(bool)))
+
+			return diag.Errorf("error updating DynamoDB Table (%s) items: %s", tableName, err)
+		}
+	}
+
+	return resourceTableItemsRead(ctx, d, meta)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+
+	if d.Get("create_only").(bool) {
+		log.Printf("[DEBUG] Keeping seeded DynamoDB Table (%s) items", tableName)
+		return nil
+	}
+
+	requests, err := tableItemsDeleteRequests(d.Get("item_hashes").(map[string]interface{}), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting %d DynamoDB Table (%s) items", len(requests), tableName)
+
+	err = batchWriteTableItems(ctx, conn, tableName, requests, d.Get("max_concurrency").(int))
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	return nil
+}
+
+// resourceTableItemsCustomizeDiff sets item_hashes to the hashes of the configured items,
+// so that items that were added, changed or removed in the configuration or outside of Terraform
+// show as differences.
+func resourceTableItemsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"create_only", "format", "hash_key", "items", "items_file", "range_key"} {
+		if !diff.NewValueKnown(k) {
+			if err := diff.SetNewComputed("item_count"); err != nil {
+				return err
+			}
+
+			return diff.SetNewComputed("item_hashes")
+		}
+	}
+
+	items, err := tableItemsByKeyID(diff, diff.Get("hash_key").(string), diff.Get("range_key").(string))
+
+	if err != nil {
+		return err
+	}
+
+	hashes, err := tableItemsHashes(items)
+
+	if err != nil {
+		return err
+	}
+
+	oldHashes := diff.Get("item_hashes").(map[string]interface{})
+
+	// Seeded items aren't updated.
+	if diff.Id() != "" && diff.Get("create_only").(bool) {
+		for keyID := range hashes {
+			if hash, ok := oldHashes[keyID]; ok {
+				hashes[keyID] = hash
+			}
+		}
+	}
+
+	if diff.Id() != "" && reflect.DeepEqual(oldHashes, hashes) {
+		return nil
+	}
+
+	if err := diff.SetNew("item_count", len(hashes)); err != nil {
+		return err
+	}
+
+	return diff.SetNew("item_hashes", hashes)
+}
+
+// tableItemsByKeyID returns the configured items by the DynamoDB JSON of their key.
+func tableItemsByKeyID(d interface{ Get(string) interface{} }, hashKey, rangeKey string) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	input := d.Get("items").(string)
+
+	if v := d.Get("items_file").(string); v != "" {
+		filename, err := homedir.Expand(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := os.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading items file: %w", err)
+		}
+
+		input = string(b)
+	}
+
+	items, err := ExpandTableItems(input, d.Get("format").(string) == TableItemsFormatJSON)
+
+	if err != nil {
+		return nil, err
+	}
+
+	byKeyID := make(map[string]map[string]*dynamodb.AttributeValue, len(items))
+
+	for i, item := range items {
+		keyID, err := TableItemKeyID(item, hashKey, rangeKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i+1, err)
+		}
+
+		if _, ok := byKeyID[keyID]; ok {
+			return nil, fmt.Errorf("item %d: duplicate key %s", i+1, keyID)
+		}
+
+		byKeyID[keyID] = item
+	}
+
+	return byKeyID, nil
+}
+
+func tableItemsHashes(items map[string]map[string]*dynamodb.AttributeValue) (map[string]interface{}, error) {
+	hashes := make(map[string]interface{}, len(items))
+
+	for keyID, item := range items {
+		hash, err := TableItemHash(item)
+
+		if err != nil {
+			return nil, fmt.Errorf("hashing item %s: %w", keyID, err)
+		}
+
+		hashes[keyID] = hash
+	}
+
+	return hashes, nil
+}
+
+// tableItemsFailedUpdateHashes returns the item hashes to record when an update was only partially written.
+// Seeded items are checked for existence on the next update, so the old hashes are kept.
+// Otherwise items new to the configuration are added with an empty hash, so that they are written again
+// or deleted by the next apply, and the old hashes of other items are kept so that their changes are retried.
+func tableItemsFailedUpdateHashes(oldHashes, newHashes map[string]interface{}, createOnly bool) map[string]interface{} {
+	hashes := make(map[string]interface{}, len(oldHashes))
+
+	for keyID, hash := range oldHashes {
+		hashes[keyID] = hash
+	}
+
+	if createOnly {
+		return hashes
+	}
+
+	for keyID := range newHashes {
+		if _, ok := hashes[keyID]; !ok {
+			hashes[keyID] = ""
+		}
+	}
+
+	return hashes
+}
+
+func tableItemsKeyIDs(items map[string]map[string]*dynamodb.AttributeValue) []string {
+	keyIDs := make([]string, 0, len(items))
+
+	for keyID := range items {
+		keyIDs = append(keyIDs, keyID)
+	}
+
+	sort.Strings(keyIDs)
+
+	return keyIDs
+}
+
+// tableItemsDeleteRequests returns requests to delete the items that are in old but not in new.
+func tableItemsDeleteRequests(oldHashes, newHashes map[string]interface{}) ([]*dynamodb.WriteRequest, error) {
+	var keyIDs []string
+
+	for keyID := range oldHashes {
+		if _, ok := newHashes[keyID]; !ok {
+			keyIDs = append(keyIDs, keyID)
+		}
+	}
+
+	sort.Strings(keyIDs)
+
+	requests := make([]*dynamodb.WriteRequest, 0, len(keyIDs))
+
+	for _, keyID := range keyIDs {
+		key, err := ExpandTableItemAttributes(keyID)
+
+		if err != nil {
+			return nil, fmt.Errorf("item key %s: %w", keyID, err)
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{DeleteRequest: &dynamodb.DeleteRequest{Key: key}})
+	}
+
+	return requests, nil
+}
+
+// batchGetTableItems returns the items with the keys that exist, by the DynamoDB JSON of their key.
+// Keys are read in batches, up to concurrency batches at a time, and unprocessed keys are retried.
+func batchGetTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, keyIDs []string, hashKey, rangeKey string, concurrency int) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	allKeys := make([]map[string]*dynamodb.AttributeValue, 0, len(keyIDs))
+
+	for _, keyID := range keyIDs {
+		key, err := ExpandTableItemAttributes(keyID)
+
+		if err != nil {
+			return nil, fmt.Errorf("item key %s: %w", keyID, err)
+		}
+
+		allKeys = append(allKeys, key)
+	}
+
+	items := make(map[string]map[string]*dynamodb.AttributeValue)
+
+	var g multierror.Group
+	var mu sync.Mutex
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < len(allKeys); i += tableItemsBatchGetMaxKeys {
+		keys := allKeys[i:tableItemsMin(i+tableItemsBatchGetMaxKeys, len(allKeys))]
+
+		sem <- struct{}{}
+		g.Go(func() error {
+			defer func() { <-sem }()
+
+			return tableItemsRetry(ctx, func() (int, error) {
+				output, err := conn.BatchGetItemWithContext(ctx, &dynamodb.BatchGetItemInput{
+					RequestItems: map[string]*dynamodb.KeysAndAttributes{
+						tableName: {
+							ConsistentRead: aws.Bool(true),
+							Keys:           keys,
+						},
+					},
+				})
+
+				if err != nil {
+					return 0, err
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				for _, item := range output.Responses[tableName] {
+					keyID, err := TableItemKeyID(item, hashKey, rangeKey)
+
+					if err != nil {
+						return 0, err
+					}
+
+					items[keyID] = item
+				}
+
+				keys = nil
+
+				if v, ok := output.UnprocessedKeys[tableName]; ok && v != nil {
+					keys = v.Keys
+				}
+
+				return len(keys), nil
+			})
+		})
+	}
+
+	if err := g.Wait().ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// batchWriteTableItems writes the requests in batches, up to concurrency batches at a time,
+// and retries unprocessed requests.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, requests []*dynamodb.WriteRequest, concurrency int) error {
+	var g multierror.Group
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < len(requests); i += tableItemsBatchWriteMaxRequests {
+		batch := requests[i:tableItemsMin(i+tableItemsBatchWriteMaxRequests, len(requests))]
+
+		sem <- struct{}{}
+		g.Go(func() error {
+			defer func() { <-sem }()
+
+			return tableItemsRetry(ctx, func() (int, error) {
+				output, err := conn.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+					RequestItems: map[string][]*dynamodb.WriteRequest{
+						tableName: batch,
+					},
+				})
+
+				if err != nil {
+					return 0, err
+				}
+
+				batch = output.UnprocessedItems[tableName]
+
+				return len(batch), nil
+			})
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// tableItemsRetry calls f until it has no unprocessed items, with exponential backoff between calls.
+func tableItemsRetry(ctx context.Context, f func() (int, error)) error {
+	delay := 100 * time.Millisecond
+
+	for {
+		unprocessed, err := f()
+
+		if err != nil {
+			return err
+		}
+
+		if unprocessed == 0 {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Retrying %d unprocessed DynamoDB items in %s", unprocessed, delay)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d items unprocessed: %w", unprocessed, ctx.Err())
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > tableItemsRetryMaxDelay {
+			delay = tableItemsRetryMaxDelay
+		}
+	}
+}
+
+func tableItemsMin(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// tableItemsKeyIDsString returns a short description of the keys, for messages.
+func tableItemsKeyIDsString(keyIDs []string) string {
+	const max = 3
+
+	if len(keyIDs) <= max {
+		return strings.Join(keyIDs, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(keyIDs[:max], ", "), len(keyIDs)-max)
+}
@@ -0,0 +1,496 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestExpandTableItems(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Plain    bool
+		Expected []string
+		Error    bool
+	}{
+		{
+			Name:     "DynamoDB JSON array",
+			Input:    `[{"id": {"S": "a"}, "n": {"N": "1"}}, {"id": {"S": "b"}}]`,
+			Expected: []string{`{"id":{"S":"a"},"n":{"N":"1"}}`, `{"id":{"S":"b"}}`},
+		},
+		{
+			Name:     "DynamoDB JSON Lines",
+			Input:    "{\"id\": {\"S\": \"a\"}}\n\n{\"id\": {\"S\": \"b\"}}\n",
+			Expected: []string{`{"id":{"S":"a"}}`, `{"id":{"S":"b"}}`},
+		},
+		{
+			Name:     "plain JSON",
+			Input:    `[{"id": "a", "n": 12345678901234567890, "ok": true, "none": null, "l": [1, "x"], "m": {"k": "v"}}]`,
+			Plain:    true,
+			Expected: []string{`{"id":{"S":"a"},"l":{"L":[{"N":"1"},{"S":"x"}]},"m":{"M":{"k":{"S":"v"}}},"n":{"N":"12345678901234567890"},"none":{"NULL":true},"ok":{"BOOL":true}}`},
+		},
+		{
+			Name:     "plain JSON Lines",
+			Input:    "{\"id\": \"a\"}\n{\"id\": \"b\"}",
+			Plain:    true,
+			Expected: []string{`{"id":{"S":"a"}}`, `{"id":{"S":"b"}}`},
+		},
+		{
+			Name:  "not an object",
+			Input: `["a"]`,
+			Plain: true,
+			Error: true,
+		},
+		{
+			Name:  "invalid JSON",
+			Input: `{"id": {"S": "a"}`,
+			Error: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			items, err := tfdynamodb.ExpandTableItems(testCase.Input, testCase.Plain)
+
+			if testCase.Error {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(items) != len(testCase.Expected) {
+				t.Fatalf("got %d items, expected %d", len(items), len(testCase.Expected))
+			}
+
+			for i, item := range items {
+				expected, err := tfdynamodb.ExpandTableItemAttributes(testCase.Expected[i])
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				gotHash, _ := tfdynamodb.TableItemHash(item)
+				expectedHash, _ := tfdynamodb.TableItemHash(expected)
+
+				if gotHash != expectedHash {
+					t.Errorf("item %d: got %s, expected %s", i, item, testCase.Expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTableItemKeyID(t *testing.T) {
+	item := map[string]*dynamodb.AttributeValue{
+		"id":    {S: aws.String("a")},
+		"sort":  {N: aws.String("1")},
+		"data":  {B: []byte("data")},
+		"tags":  {SS: aws.StringSlice([]string{"x"})},
+		"other": {S: aws.String("b")},
+	}
+
+	testCases := []struct {
+		HashKey  string
+		RangeKey string
+		Expected string
+		Error    bool
+	}{
+		{HashKey: "id", Expected: `{"id":{"S":"a"}}`},
+		{HashKey: "id", RangeKey: "sort", Expected: `{"id":{"S":"a"},"sort":{"N":"1"}}`},
+		{HashKey: "data", Expected: `{"data":{"B":"ZGF0YQ=="}}`},
+		{HashKey: "missing", Error: true},
+		{HashKey: "id", RangeKey: "tags", Error: true},
+	}
+
+	for _, testCase := range testCases {
+		got, err := tfdynamodb.TableItemKeyID(item, testCase.HashKey, testCase.RangeKey)
+
+		if testCase.Error {
+			if err == nil {
+				t.Errorf("%s/%s: expected error", testCase.HashKey, testCase.RangeKey)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s/%s: unexpected error: %s", testCase.HashKey, testCase.RangeKey, err)
+			continue
+		}
+
+		if got != testCase.Expected {
+			t.Errorf("%s/%s: got %s, expected %s", testCase.HashKey, testCase.RangeKey, got, testCase.Expected)
+		}
+
+		// The key ID is the DynamoDB JSON of the key.
+		if _, err := tfdynamodb.ExpandTableItemAttributes(got); err != nil {
+			t.Errorf("%s/%s: key ID isn't DynamoDB JSON: %s", testCase.HashKey, testCase.RangeKey, err)
+		}
+	}
+}
+
+func TestTableItemKeyID_numbers(t *testing.T) {
+	testCases := []struct {
+		Number   string
+		Expected string
+	}{
+		{Number: "15", Expected: "15"},
+		{Number: "1.50", Expected: "1.5"},
+		{Number: "1e3", Expected: "1000"},
+		{Number: "1.5E-3", Expected: "0.0015"},
+		{Number: "123.456e2", Expected: "12345.6"},
+		{Number: "+0120", Expected: "120"},
+		{Number: "-0.0100", Expected: "-0.01"},
+		{Number: "0.0", Expected: "0"},
+		{Number: "-0", Expected: "0"},
+	}
+
+	for _, testCase := range testCases {
+		item := map[string]*dynamodb.AttributeValue{
+			"id": {N: aws.String(testCase.Number)},
+		}
+
+		got, err := tfdynamodb.TableItemKeyID(item, "id", "")
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", testCase.Number, err)
+			continue
+		}
+
+		if expected := `{"id":{"N":"` + testCase.Expected + `"}}`; got != expected {
+			t.Errorf("%s: got %s, expected %s", testCase.Number, got, expected)
+		}
+	}
+}
+
+func TestTableItemHash(t *testing.T) {
+	hash := func(s string) string {
+		item, err := tfdynamodb.ExpandTableItemAttributes(s)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		hash, err := tfdynamodb.TableItemHash(item)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return hash
+	}
+
+	base := hash(`{"id": {"S": "a"}, "tags": {"SS": ["x", "y"]}, "l": {"L": [{"N": "1"}, {"N": "2"}]}}`)
+
+	if got := hash(`{"l": {"L": [{"N": "1"}, {"N": "2"}]}, "tags": {"SS": ["y", "x"]}, "id": {"S": "a"}}`); got != base {
+		t.Error("expected the order of attributes and set elements not to change the hash")
+	}
+
+	if got := hash(`{"id": {"S": "a"}, "tags": {"SS": ["x", "y"]}, "l": {"L": [{"N": "2"}, {"N": "1"}]}}`); got == base {
+		t.Error("expected the order of list elements to change the hash")
+	}
+
+	if got := hash(`{"id": {"S": "a"}, "tags": {"SS": ["x", "y"]}, "l": {"L": [{"S": "1"}, {"N": "2"}]}}`); got == base {
+		t.Error("expected the type of values to change the hash")
+	}
+
+	if got := hash(`{"id": {"S": "a"}, "tags": {"SS": ["x", "y"]}, "l": {"L": [{"N": "1.0"}, {"N": "0.2e1"}]}}`); got != base {
+		t.Error("expected the formatting of numbers not to change the hash")
+	}
+
+	if got, expected := hash(`{"ns": {"NS": ["1e3", "2.50"]}}`), hash(`{"ns": {"NS": ["2.5", "1000"]}}`); got != expected {
+		t.Error("expected the formatting of number set elements not to change the hash")
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_items(rName, `[
+  {"id": {"S": "a"}, "value": {"N": "1"}},
+  {"id": {"S": "b"}, "value": {"N": "2"}},
+  {"id": {"S": "c"}, "value": {"SS": ["x", "y"]}}
+]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"id":{"S":"a"}}`),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_items(rName, `[
+  {"id": {"S": "a"}, "value": {"N": "10"}},
+  {"id": {"S": "c"}, "value": {"SS": ["y", "x"]}},
+  {"id": {"S": "d"}, "value": {"N": "4"}}
+]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 3),
+					testAccCheckTableItemsValue(rName, "a", "10"),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, `item_hashes.{"id":{"S":"b"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_drift(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_items(rName, `[{"id": {"S": "a"}, "value": {"N": "1"}}, {"id": {"S": "b"}, "value": {"N": "2"}}]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsPut(rName, "a", "100"),
+					testAccCheckTableItemsDelete(rName, "b"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTableItemsConfig_items(rName, `[{"id": {"S": "a"}, "value": {"N": "1"}}, {"id": {"S": "b"}, "value": {"N": "2"}}]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 2),
+					testAccCheckTableItemsValue(rName, "a", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_itemsFile(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	filename := filepath.Join(t.TempDir(), "items.jsonl")
+
+	if err := os.WriteFile(filename, []byte("{\"id\": \"a\", \"value\": 1}\n{\"id\": \"b\", \"value\": 2}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_itemsFile(rName, filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 2),
+					testAccCheckTableItemsValue(rName, "b", "2"),
+					resource.TestCheckResourceAttr(resourceName, "format", tfdynamodb.TableItemsFormatJSON),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_createOnly(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_createOnly(rName, `[{"id": {"S": "a"}, "value": {"N": "1"}}]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 1),
+					// Changes outside of Terraform are kept.
+					testAccCheckTableItemsPut(rName, "a", "100"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_createOnly(rName, `[{"id": {"S": "a"}, "value": {"N": "2"}}, {"id": {"S": "b"}, "value": {"N": "2"}}]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 2),
+					testAccCheckTableItemsValue(rName, "a", "100"),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_duplicateKey(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableItemsConfig_items(rName, `[{"id": {"S": "a"}}, {"id": {"S": "a"}}]`),
+				ExpectError: regexp.MustCompile(`item 2: duplicate key {"id":{"S":"a"}}`),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_items" {
+			continue
+		}
+
+		out, err := conn.Scan(&dynamodb.ScanInput{
+			ConsistentRead: aws.Bool(true),
+			TableName:      aws.String(rs.Primary.Attributes["table_name"]),
+			Select:         aws.String(dynamodb.SelectCount),
+		})
+
+		if err != nil {
+			// The table is destroyed with the items.
+			continue
+		}
+
+		if aws.Int64Value(out.Count) != 0 {
+			return fmt.Errorf("DynamoDB Table (%s) still has %d items", rs.Primary.ID, aws.Int64Value(out.Count))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTableItemsValue(tableName, id, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		out, err := conn.GetItem(&dynamodb.GetItemInput{
+			ConsistentRead: aws.Bool(true),
+			Key:            map[string]*dynamodb.AttributeValue{"id": {S: aws.String(id)}},
+			TableName:      aws.String(tableName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if out.Item == nil || out.Item["value"] == nil || aws.StringValue(out.Item["value"].N) != value {
+			return fmt.Errorf("expected DynamoDB Table (%s) item %q to have value %s, got %v", tableName, id, value, out.Item)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsPut(tableName, id, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		_, err := conn.PutItem(&dynamodb.PutItemInput{
+			Item: map[string]*dynamodb.AttributeValue{
+				"id":    {S: aws.String(id)},
+				"value": {N: aws.String(value)},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckTableItemsDelete(tableName, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		_, err := conn.DeleteItem(&dynamodb.DeleteItemInput{
+			Key:       map[string]*dynamodb.AttributeValue{"id": {S: aws.String(id)}},
+			TableName: aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccTableItemsBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_items(rName, items string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = <<ITEMS
+%[1]s
+ITEMS
+}
+`, items))
+}
+
+func testAccTableItemsConfig_itemsFile(rName, filename string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  format     = "json"
+  items_file = %[1]q
+}
+`, filename))
+}
+
+func testAccTableItemsConfig_createOnly(rName, items string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name  = aws_dynamodb_table.test.name
+  hash_key    = aws_dynamodb_table.test.hash_key
+  create_only = true
+
+  items = <<ITEMS
+%[1]s
+ITEMS
+}
+`, items))
+}
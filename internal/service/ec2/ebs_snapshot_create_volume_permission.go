@@ -34,6 +34,7 @@ func ResourceSnapshotCreateVolumePermission() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
@@ -63,6 +63,7 @@ func resourceAvailabilityZoneGroupCreate(d *schema.ResourceData, meta interface{
 func resourceAvailabilityZoneGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	//lintignore:AWSR003
 	availabilityZone, err := FindAvailabilityZoneGroupByName(conn, d.Id())
 
 	if err != nil {
@@ -107,6 +107,7 @@ func resourceIPAMPoolCIDRCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceIPAMPoolCIDRRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
+	//lintignore:AWSR003
 	cidr, pool_id, err := FindIPAMPoolCIDR(conn, d.Id())
 
 	if err != nil {
@@ -128,6 +128,7 @@ func resourceIPAMPoolCIDRAllocationCreate(d *schema.ResourceData, meta interface
 func resourceIPAMPoolCIDRAllocationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	//lintignore:AWSR003
 	cidr_allocation, pool_id, err := FindIPAMPoolCIDRAllocation(conn, d.Id())
 
 	if err != nil {
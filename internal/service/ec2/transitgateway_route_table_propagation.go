@@ -79,6 +79,7 @@ func resourceTransitGatewayRouteTablePropagationRead(d *schema.ResourceData, met
 		return err
 	}
 
+	//lintignore:AWSR003
 	transitGatewayPropagation, err := FindTransitGatewayRouteTablePropagation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
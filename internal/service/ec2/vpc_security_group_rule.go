@@ -151,6 +151,7 @@ func ResourceSecurityGroupRule() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	sg_id := d.Get("security_group_id").(string)
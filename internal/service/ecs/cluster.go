@@ -248,6 +248,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	var cluster *ecs.Cluster
 	err := resource.Retry(clusterReadTimeout, func() *resource.RetryError {
 		var err error
+		//lintignore:AWSR003
 		cluster, err = FindClusterByNameOrARN(context.Background(), conn, d.Id())
 
 		if d.IsNewResource() && tfresource.NotFound(err) {
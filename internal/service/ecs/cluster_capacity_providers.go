@@ -103,6 +103,7 @@ func resourceClusterCapacityProvidersPut(ctx context.Context, d *schema.Resource
 func resourceClusterCapacityProvidersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECSConn
 
+	//lintignore:AWSR003
 	cluster, err := FindClusterByNameOrARN(ctx, conn, d.Id())
 
 	if tfresource.NotFound(err) {
@@ -0,0 +1,308 @@
+package ecs
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceContainerDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainerDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"container", "container_definitions"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cpu": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"docker_labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"entry_point": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"essential": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"memory": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"memory_reservation": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port_mapping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_port": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"host_port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"protocol": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  ecs.TransportProtocolTcp,
+									},
+								},
+							},
+						},
+						"secret": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value_from": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"working_directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"container_definitions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"container", "container_definitions"},
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.NetworkMode_Values(), false),
+			},
+			"task_memory": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceContainerDefinitionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var definitions []*ecs.ContainerDefinition
+
+	if v, ok := d.GetOk("container_definitions"); ok {
+		for _, err := range validContainerDefinitionsKeys(v.(string)) {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		var err error
+		definitions, err = expandContainerDefinitions(v.(string))
+
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	} else {
+		definitions = expandContainerDefinitionsContainers(d.Get("container").([]interface{}))
+	}
+
+	networkMode := d.Get("network_mode").(string)
+
+	for _, err := range validContainerDefinitions(definitions, networkMode, int64(d.Get("task_memory").(int))) {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	canonicalJSON, err := containerDefinitions(definitions).Canonicalize(networkMode == ecs.NetworkModeAwsvpc)
+
+	if err != nil {
+		return diag.Errorf("canonicalizing ECS container definitions: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(string(canonicalJSON))))
+	d.Set("json", string(canonicalJSON))
+
+	return diags
+}
+
+func expandContainerDefinitionsContainers(tfList []interface{}) []*ecs.ContainerDefinition {
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DockerLabels = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+			for k, v := range v {
+				apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+					Name:  aws.String(k),
+					Value: aws.String(v.(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.LogConfiguration = &ecs.LogConfiguration{
+				LogDriver: aws.String(tfMap["log_driver"].(string)),
+			}
+
+			if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.LogConfiguration.Options = flex.ExpandStringMap(v)
+			}
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		for _, tfMapRaw := range tfMap["port_mapping"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			portMapping := &ecs.PortMapping{
+				ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+				Protocol:      aws.String(tfMap["protocol"].(string)),
+			}
+
+			if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+				portMapping.HostPort = aws.Int64(int64(v))
+			}
+
+			apiObject.PortMappings = append(apiObject.PortMappings, portMapping)
+		}
+
+		for _, tfMapRaw := range tfMap["secret"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Secrets = append(apiObject.Secrets, &ecs.Secret{
+				Name:      aws.String(tfMap["name"].(string)),
+				ValueFrom: aws.String(tfMap["value_from"].(string)),
+			})
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
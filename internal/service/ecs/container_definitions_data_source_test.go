@@ -0,0 +1,114 @@
+package ecs_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSContainerDefinitionsDataSource_container(t *testing.T) {
+	dataSourceName := "data.aws_ecs_container_definitions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDataSourceConfig_container,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx:latest","memory":128,"name":"web","portMappings":[{"containerPort":80,"hostPort":80}],"secrets":[{"name":"C","valueFrom":"arn:aws:ssm:us-west-2:123456789012:parameter/c"}]}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDataSource_containerDefinitions(t *testing.T) {
+	dataSourceName := "data.aws_ecs_container_definitions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDataSourceConfig_containerDefinitions,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx:latest","memoryReservation":64,"name":"web","portMappings":[{"containerPort":8080}]}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDataSource_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerDefinitionsDataSourceConfig_invalid,
+				ExpectError: regexp.MustCompile(`unknown key "memoryreservation", did you mean "memoryReservation"\?`),
+			},
+		},
+	})
+}
+
+const testAccContainerDefinitionsDataSourceConfig_container = `
+data "aws_ecs_container_definitions" "test" {
+  network_mode = "awsvpc"
+
+  container {
+    name   = "web"
+    image  = "nginx:latest"
+    memory = 128
+
+    environment = {
+      B = "2"
+      A = "1"
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+
+    secret {
+      name       = "C"
+      value_from = "arn:aws:ssm:us-west-2:123456789012:parameter/c"
+    }
+  }
+}
+`
+
+const testAccContainerDefinitionsDataSourceConfig_containerDefinitions = `
+data "aws_ecs_container_definitions" "test" {
+  container_definitions = jsonencode([{
+    name              = "web"
+    image             = "nginx:latest"
+    cpu               = 0
+    memoryReservation = 64
+    environment = [
+      { name = "B", value = "2" },
+      { name = "A", value = "1" },
+    ]
+    portMappings = [
+      { containerPort = 8080, hostPort = 0, protocol = "tcp" },
+    ]
+  }])
+}
+`
+
+const testAccContainerDefinitionsDataSourceConfig_invalid = `
+data "aws_ecs_container_definitions" "test" {
+  container_definitions = jsonencode([{
+    name              = "web"
+    image             = "nginx:latest"
+    memoryreservation = 64
+  }])
+}
+`
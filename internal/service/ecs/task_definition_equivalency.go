@@ -21,11 +21,7 @@ func ContainerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 	if err != nil {
 		return false, err
 	}
-	err = obj1.Reduce(isAWSVPC)
-	if err != nil {
-		return false, err
-	}
-	canonicalJson1, err := jsonutil.BuildJSON(obj1)
+	canonicalJson1, err := obj1.Canonicalize(isAWSVPC)
 	if err != nil {
 		return false, err
 	}
@@ -35,12 +31,7 @@ func ContainerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 	if err != nil {
 		return false, err
 	}
-	err = obj2.Reduce(isAWSVPC)
-	if err != nil {
-		return false, err
-	}
-
-	canonicalJson2, err := jsonutil.BuildJSON(obj2)
+	canonicalJson2, err := obj2.Canonicalize(isAWSVPC)
 	if err != nil {
 		return false, err
 	}
@@ -102,6 +93,15 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 	return nil
 }
 
+// Canonicalize reduces the container definitions and returns them as JSON.
+func (cd containerDefinitions) Canonicalize(isAWSVPC bool) ([]byte, error) {
+	if err := cd.Reduce(isAWSVPC); err != nil {
+		return nil, err
+	}
+
+	return jsonutil.BuildJSON(cd)
+}
+
 func (cd containerDefinitions) OrderEnvironmentVariables() {
 	for _, def := range cd {
 		sort.Slice(def.Environment, func(i, j int) bool {
@@ -1,12 +1,23 @@
 package ecs
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
+	"sort"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	// containerDefinitionMinMemory is the minimum memory, in MiB, of a container.
+	containerDefinitionMinMemory = 6
+)
+
 func validateClusterName(v interface{}, k string) (ws []string, errors []error) {
 	return validation.All(
 		validation.StringLenBetween(1, 255),
@@ -59,3 +70,248 @@ func validPlacementStrategy(stratType, stratField string) error {
 	}
 	return nil
 }
+
+// validContainerDefinitionsKeys checks that the JSON container definitions only use keys known to
+// RegisterTaskDefinition. Keys are case sensitive.
+func validContainerDefinitionsKeys(rawDefinitions string) []error {
+	var definitions []interface{}
+
+	if err := json.Unmarshal([]byte(rawDefinitions), &definitions); err != nil {
+		return []error{fmt.Errorf("Error decoding JSON: %s", err)}
+	}
+
+	var errs []error
+
+	for i, definition := range definitions {
+		errs = append(errs, validContainerDefinitionsKeysOfType(definition, reflect.TypeOf(ecs.ContainerDefinition{}), fmt.Sprintf("[%d]", i))...)
+	}
+
+	return errs
+}
+
+func validContainerDefinitionsKeysOfType(v interface{}, t reflect.Type, path string) []error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var errs []error
+
+	// Values of the wrong type are reported when decoding.
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		fields := make(map[string]reflect.Type)
+		for i := 0; i < t.NumField(); i++ {
+			if name := t.Field(i).Tag.Get("locationName"); name != "" {
+				fields[name] = t.Field(i).Type
+			}
+		}
+
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if ft, ok := fields[k]; ok {
+				errs = append(errs, validContainerDefinitionsKeysOfType(m[k], ft, path+"."+k)...)
+				continue
+			}
+
+			err := fmt.Errorf("container definition %s: unknown key %q", path, k)
+			for name := range fields {
+				if strings.EqualFold(name, k) {
+					err = fmt.Errorf("container definition %s: unknown key %q, did you mean %q?", path, k, name)
+					break
+				}
+			}
+			errs = append(errs, err)
+		}
+	case reflect.Slice:
+		l, ok := v.([]interface{})
+		if !ok {
+			return nil
+		}
+
+		for i, e := range l {
+			errs = append(errs, validContainerDefinitionsKeysOfType(e, t.Elem(), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+
+	return errs
+}
+
+// validContainerDefinitions checks the container definitions of a task definition for the following
+// problems, which RegisterTaskDefinition would otherwise report:
+//   - Missing or duplicate container names and missing images
+//   - Invalid or conflicting port mappings, including host ports that differ from container ports in awsvpc and host network mode
+//   - Duplicate environment variables and secrets with the same name as an environment variable
+//   - Memory limits that are too small, a memory reservation above the memory limit, containers without
+//     memory when the task has none, and containers reserving more memory than the task has
+func validContainerDefinitions(definitions []*ecs.ContainerDefinition, networkMode string, taskMemory int64) []error {
+	if len(definitions) == 0 {
+		return []error{fmt.Errorf("at least one container definition is required")}
+	}
+
+	var errs []error
+	names := make(map[string]bool)
+	hostPorts := make(map[string]string)
+	var reserved int64
+
+	for i, definition := range definitions {
+		if definition == nil {
+			errs = append(errs, fmt.Errorf("container definition [%d] is empty", i))
+			continue
+		}
+
+		name := aws.StringValue(definition.Name)
+		label := fmt.Sprintf("container definition [%d]", i)
+
+		if name == "" {
+			errs = append(errs, fmt.Errorf("%s: name is required", label))
+		} else {
+			label = fmt.Sprintf("container definition [%d] (%s)", i, name)
+
+			if names[name] {
+				errs = append(errs, fmt.Errorf("%s: duplicate container name", label))
+			}
+			names[name] = true
+		}
+
+		if aws.StringValue(definition.Image) == "" {
+			errs = append(errs, fmt.Errorf("%s: image is required", label))
+		}
+
+		memory := aws.Int64Value(definition.Memory)
+		memoryReservation := aws.Int64Value(definition.MemoryReservation)
+
+		if definition.Memory != nil && memory < containerDefinitionMinMemory {
+			errs = append(errs, fmt.Errorf("%s: memory (%d) must be at least %d MiB", label, memory, containerDefinitionMinMemory))
+		}
+
+		if definition.MemoryReservation != nil && memoryReservation < containerDefinitionMinMemory {
+			errs = append(errs, fmt.Errorf("%s: memoryReservation (%d) must be at least %d MiB", label, memoryReservation, containerDefinitionMinMemory))
+		}
+
+		if definition.Memory != nil && definition.MemoryReservation != nil && memoryReservation > memory {
+			errs = append(errs, fmt.Errorf("%s: memoryReservation (%d) must not be greater than memory (%d)", label, memoryReservation, memory))
+		}
+
+		if taskMemory == 0 && definition.Memory == nil && definition.MemoryReservation == nil {
+			errs = append(errs, fmt.Errorf("%s: memory or memoryReservation is required when the task has no memory", label))
+		}
+
+		if taskMemory > 0 && memory > taskMemory {
+			errs = append(errs, fmt.Errorf("%s: memory (%d) must not be greater than the task memory (%d)", label, memory, taskMemory))
+		}
+
+		if definition.MemoryReservation != nil {
+			reserved += memoryReservation
+		} else {
+			reserved += memory
+		}
+
+		environment := make(map[string]bool)
+
+		for _, v := range definition.Environment {
+			if v == nil {
+				continue
+			}
+
+			k := aws.StringValue(v.Name)
+			if environment[k] {
+				errs = append(errs, fmt.Errorf("%s: duplicate environment variable %q", label, k))
+			}
+			environment[k] = true
+		}
+
+		for _, v := range definition.Secrets {
+			if v == nil {
+				continue
+			}
+
+			k := aws.StringValue(v.Name)
+			if k == "" || aws.StringValue(v.ValueFrom) == "" {
+				errs = append(errs, fmt.Errorf("%s: secrets require a name and valueFrom", label))
+				continue
+			}
+
+			if environment[k] {
+				errs = append(errs, fmt.Errorf("%s: %q is both an environment variable and a secret", label, k))
+			}
+			environment[k] = true
+		}
+
+		containerPorts := make(map[string]bool)
+
+		for j, v := range definition.PortMappings {
+			if v == nil {
+				continue
+			}
+
+			containerPort := aws.Int64Value(v.ContainerPort)
+			hostPort := aws.Int64Value(v.HostPort)
+			protocol := aws.StringValue(v.Protocol)
+			if protocol == "" {
+				protocol = ecs.TransportProtocolTcp
+			}
+			portLabel := fmt.Sprintf("%s: port mapping [%d]", label, j)
+
+			if containerPort < 1 || containerPort > 65535 {
+				errs = append(errs, fmt.Errorf("%s: containerPort (%d) must be between 1 and 65535", portLabel, containerPort))
+			}
+
+			if hostPort < 0 || hostPort > 65535 {
+				errs = append(errs, fmt.Errorf("%s: hostPort (%d) must be between 0 and 65535", portLabel, hostPort))
+			}
+
+			if !validContainerDefinitionsProtocol(protocol) {
+				errs = append(errs, fmt.Errorf("%s: protocol (%s) must be one of %s", portLabel, protocol, strings.Join(ecs.TransportProtocol_Values(), ", ")))
+			}
+
+			if (networkMode == ecs.NetworkModeAwsvpc || networkMode == ecs.NetworkModeHost) && hostPort != 0 && hostPort != containerPort {
+				errs = append(errs, fmt.Errorf("%s: hostPort (%d) must be unset or equal to containerPort (%d) in %s network mode", portLabel, hostPort, containerPort, networkMode))
+			}
+
+			if k := fmt.Sprintf("%d/%s", containerPort, protocol); containerPorts[k] {
+				errs = append(errs, fmt.Errorf("%s: duplicate containerPort %s", portLabel, k))
+			} else {
+				containerPorts[k] = true
+			}
+
+			if hostPort == 0 && (networkMode == ecs.NetworkModeAwsvpc || networkMode == ecs.NetworkModeHost) {
+				hostPort = containerPort
+			}
+
+			if hostPort != 0 {
+				k := fmt.Sprintf("%d/%s", hostPort, protocol)
+				if other, ok := hostPorts[k]; ok {
+					errs = append(errs, fmt.Errorf("%s: hostPort %s is already used by %s", portLabel, k, other))
+				} else {
+					hostPorts[k] = label
+				}
+			}
+		}
+	}
+
+	if taskMemory > 0 && reserved > taskMemory {
+		errs = append(errs, fmt.Errorf("containers reserve %d MiB of memory, which is more than the task memory (%d)", reserved, taskMemory))
+	}
+
+	return errs
+}
+
+func validContainerDefinitionsProtocol(protocol string) bool {
+	for _, v := range ecs.TransportProtocol_Values() {
+		if v == protocol {
+			return true
+		}
+	}
+
+	return false
+}
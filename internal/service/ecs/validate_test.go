@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -86,3 +87,128 @@ func TestValidPlacementStrategy(t *testing.T) {
 		}
 	}
 }
+
+func TestValidContainerDefinitionsKeys(t *testing.T) {
+	cases := []struct {
+		definitions string
+		errs        []string
+	}{
+		{
+			definitions: `[{"name": "web", "image": "nginx", "portMappings": [{"containerPort": 80}], "dockerLabels": {"anyKey": "value"}}]`,
+		},
+		{
+			definitions: `[{"name": "web", "image": "nginx", "portMapping": [{"containerPort": 80}]}]`,
+			errs:        []string{`container definition [0]: unknown key "portMapping"`},
+		},
+		{
+			definitions: `[{"name": "web", "Image": "nginx", "portMappings": [{"containerport": 80}]}]`,
+			errs: []string{
+				`container definition [0]: unknown key "Image", did you mean "image"?`,
+				`container definition [0].portMappings[0]: unknown key "containerport", did you mean "containerPort"?`,
+			},
+		},
+		{
+			definitions: `[{"name": "web", "linuxParameters": {"capabilities": {"adds": ["NET_ADMIN"]}}}]`,
+			errs:        []string{`container definition [0].linuxParameters.capabilities: unknown key "adds"`},
+		},
+	}
+
+	for _, tc := range cases {
+		errs := validContainerDefinitionsKeys(tc.definitions)
+
+		if len(errs) != len(tc.errs) {
+			t.Fatalf("%s: got %d errors (%v), want %d", tc.definitions, len(errs), errs, len(tc.errs))
+		}
+
+		for i, err := range errs {
+			if err.Error() != tc.errs[i] {
+				t.Errorf("%s: got error %q, want %q", tc.definitions, err, tc.errs[i])
+			}
+		}
+	}
+}
+
+func TestValidContainerDefinitions(t *testing.T) {
+	cases := []struct {
+		definitions string
+		networkMode string
+		taskMemory  int64
+		errs        []string
+	}{
+		{
+			definitions: `[{"name": "web", "image": "nginx", "memory": 128, "portMappings": [{"containerPort": 80, "hostPort": 8080}]}]`,
+			networkMode: "bridge",
+		},
+		{
+			definitions: `[]`,
+			errs:        []string{"at least one container definition is required"},
+		},
+		{
+			definitions: `[{"image": "nginx", "memory": 128}, {"name": "web", "memory": 128}, {"name": "web", "image": "nginx", "memory": 128}]`,
+			errs: []string{
+				"container definition [0]: name is required",
+				"container definition [1] (web): image is required",
+				"container definition [2] (web): duplicate container name",
+			},
+		},
+		{
+			definitions: `[{"name": "web", "image": "nginx", "memory": 4, "memoryReservation": 8}, {"name": "sidecar", "image": "envoy"}]`,
+			errs: []string{
+				"container definition [0] (web): memory (4) must be at least 6 MiB",
+				"container definition [0] (web): memoryReservation (8) must not be greater than memory (4)",
+				"container definition [1] (sidecar): memory or memoryReservation is required when the task has no memory",
+			},
+		},
+		{
+			definitions: `[{"name": "web", "image": "nginx", "memory": 1024}, {"name": "sidecar", "image": "envoy", "memoryReservation": 256}]`,
+			taskMemory:  1024,
+			errs:        []string{"containers reserve 1280 MiB of memory, which is more than the task memory (1024)"},
+		},
+		{
+			definitions: `[{"name": "web", "image": "nginx", "memory": 128, "environment": [{"name": "A", "value": "1"}, {"name": "A", "value": "2"}], "secrets": [{"name": "A", "valueFrom": "arn"}, {"name": "B"}]}]`,
+			errs: []string{
+				`container definition [0] (web): duplicate environment variable "A"`,
+				`container definition [0] (web): "A" is both an environment variable and a secret`,
+				"container definition [0] (web): secrets require a name and valueFrom",
+			},
+		},
+		{
+			definitions: `[{"name": "web", "image": "nginx", "memory": 128, "portMappings": [{"containerPort": 80, "hostPort": 8080}, {"containerPort": 0, "protocol": "sctp"}, {"containerPort": 443}]}]`,
+			networkMode: "awsvpc",
+			errs: []string{
+				"container definition [0] (web): port mapping [0]: hostPort (8080) must be unset or equal to containerPort (80) in awsvpc network mode",
+				"container definition [0] (web): port mapping [1]: containerPort (0) must be between 1 and 65535",
+				"container definition [0] (web): port mapping [1]: protocol (sctp) must be one of tcp, udp",
+			},
+		},
+		{
+			definitions: `[{"name": "web", "image": "nginx", "memory": 128, "portMappings": [{"containerPort": 80}, {"containerPort": 80, "protocol": "tcp"}]}, {"name": "admin", "image": "nginx", "memory": 128, "portMappings": [{"containerPort": 80}]}]`,
+			networkMode: "awsvpc",
+			errs: []string{
+				"container definition [0] (web): port mapping [1]: duplicate containerPort 80/tcp",
+				"container definition [0] (web): port mapping [1]: hostPort 80/tcp is already used by container definition [0] (web)",
+				"container definition [1] (admin): port mapping [0]: hostPort 80/tcp is already used by container definition [0] (web)",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		definitions, err := expandContainerDefinitions(tc.definitions)
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.definitions, err)
+		}
+
+		errs := validContainerDefinitions(definitions, tc.networkMode, tc.taskMemory)
+
+		if len(errs) != len(tc.errs) {
+			t.Fatalf("%s: got %d errors (%v), want %d", tc.definitions, len(errs), errs, len(tc.errs))
+		}
+
+		for i, err := range errs {
+			if !strings.Contains(err.Error(), tc.errs[i]) {
+				t.Errorf("%s: got error %q, want %q", tc.definitions, err, tc.errs[i])
+			}
+		}
+	}
+}
@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
 		Name: "aws_efs_access_point",
 		F:    sweepAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
 		Name: "aws_efs_file_system",
 		F:    sweepFileSystems,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
 		Name: "aws_efs_mount_target",
 		F:    sweepMountTargets,
 	})
@@ -73,7 +73,7 @@ func sweepAccessPoints(region string) error {
 					r := ResourceAccessPoint()
 					d := r.Data(nil)
 					d.SetId(id)
-					err := r.Delete(d, client)
+					err := sweep.DeleteResource(r, d, client)
 
 					if err != nil {
 						log.Printf("[ERROR] %s", err)
@@ -115,7 +115,7 @@ func sweepFileSystems(region string) error {
 			r := ResourceFileSystem()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -0,0 +1,292 @@
+package eks
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	ClusterKubeconfigAuthenticationExec  = "exec"
+	ClusterKubeconfigAuthenticationToken = "token"
+)
+
+func ClusterKubeconfigAuthentication_Values() []string {
+	return []string{
+		ClusterKubeconfigAuthenticationExec,
+		ClusterKubeconfigAuthenticationToken,
+	}
+}
+
+func DataSourceClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClusterKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"authentication": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ClusterKubeconfigAuthenticationExec,
+				ValidateFunc: validation.StringInSlice(ClusterKubeconfigAuthentication_Values(), false),
+			},
+			"certificate_authority_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exec_api_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "client.authentication.k8s.io/v1beta1",
+				ValidateFunc: validation.StringInSlice([]string{
+					"client.authentication.k8s.io/v1",
+					"client.authentication.k8s.io/v1beta1",
+					"client.authentication.k8s.io/v1alpha1",
+				}, false),
+			},
+			"exec_env": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"session_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"role_arn"},
+				ValidateFunc: validation.StringLenBetween(2, 64),
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EKSConn
+	stsConn := meta.(*conns.AWSClient).STSConn
+	region := meta.(*conns.AWSClient).Region
+
+	name := d.Get("name").(string)
+	authentication := d.Get("authentication").(string)
+	roleARN := d.Get("role_arn").(string)
+	sessionName := d.Get("session_name").(string)
+
+	if authentication == ClusterKubeconfigAuthenticationExec && sessionName != "" {
+		return fmt.Errorf("session_name is not supported with %s authentication, the AWS CLI chooses the session name", authentication)
+	}
+
+	cluster, err := FindClusterByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading EKS Cluster (%s): %w", name, err)
+	}
+
+	config := &clusterKubeconfig{
+		ClusterName:     aws.StringValue(cluster.Arn),
+		Endpoint:        aws.StringValue(cluster.Endpoint),
+		ExecAPIVersion:  d.Get("exec_api_version").(string),
+		ExecEnvironment: d.Get("exec_env").(map[string]interface{}),
+		Name:            name,
+		Region:          region,
+		RoleARN:         roleARN,
+	}
+
+	if cluster.CertificateAuthority != nil {
+		config.CertificateAuthorityData = aws.StringValue(cluster.CertificateAuthority.Data)
+	}
+
+	d.Set("token", nil)
+	d.Set("token_expiration", nil)
+
+	if authentication == ClusterKubeconfigAuthenticationToken {
+		generator, err := NewGenerator(false, false)
+		if err != nil {
+			return fmt.Errorf("error getting token generator: %w", err)
+		}
+
+		var toke Token
+		if roleARN != "" {
+			toke, err = generator.GetWithRole(name, roleARN, sessionName, stsConn)
+		} else {
+			toke, err = generator.GetWithSTS(name, stsConn)
+		}
+		if err != nil {
+			return fmt.Errorf("error getting token: %w", err)
+		}
+
+		config.Token = toke.Token
+
+		d.Set("token", toke.Token)
+		d.Set("token_expiration", toke.Expiration.UTC().Format(time.RFC3339))
+	}
+
+	kubeconfig, err := config.render()
+
+	if err != nil {
+		return fmt.Errorf("error rendering kubeconfig for EKS Cluster (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("certificate_authority_data", config.CertificateAuthorityData)
+	d.Set("endpoint", config.Endpoint)
+	d.Set("kubeconfig", kubeconfig)
+
+	return nil
+}
+
+// clusterKubeconfig is the configuration of a kubeconfig with a single cluster, context and user.
+// If Token is set, the user authenticates with it. Otherwise the user authenticates with an
+// exec plugin that runs "aws eks get-token".
+type clusterKubeconfig struct {
+	CertificateAuthorityData string
+	ClusterName              string
+	Endpoint                 string
+	ExecAPIVersion           string
+	ExecEnvironment          map[string]interface{}
+	Name                     string
+	Region                   string
+	RoleARN                  string
+	Token                    string
+}
+
+type kubeconfig struct {
+	APIVersion     string                 `yaml:"apiVersion"`
+	Kind           string                 `yaml:"kind"`
+	Clusters       []kubeconfigCluster    `yaml:"clusters"`
+	Contexts       []kubeconfigContext    `yaml:"contexts"`
+	CurrentContext string                 `yaml:"current-context"`
+	Preferences    map[string]interface{} `yaml:"preferences"`
+	Users          []kubeconfigUser       `yaml:"users"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+		Server                   string `yaml:"server"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+type kubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		Exec  *kubeconfigExec `yaml:"exec,omitempty"`
+		Token string          `yaml:"token,omitempty"`
+	} `yaml:"user"`
+}
+
+type kubeconfigExec struct {
+	APIVersion      string                 `yaml:"apiVersion"`
+	Args            []string               `yaml:"args"`
+	Command         string                 `yaml:"command"`
+	Env             []kubeconfigExecEnvVar `yaml:"env,omitempty"`
+	InteractiveMode string                 `yaml:"interactiveMode,omitempty"`
+}
+
+type kubeconfigExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// render returns the kubeconfig as YAML.
+func (c *clusterKubeconfig) render() (string, error) {
+	cluster := kubeconfigCluster{Name: c.ClusterName}
+	cluster.Cluster.CertificateAuthorityData = c.CertificateAuthorityData
+	cluster.Cluster.Server = c.Endpoint
+
+	context := kubeconfigContext{Name: c.ClusterName}
+	context.Context.Cluster = c.ClusterName
+	context.Context.User = c.ClusterName
+
+	user := kubeconfigUser{Name: c.ClusterName}
+
+	if c.Token != "" {
+		user.User.Token = c.Token
+	} else {
+		exec := &kubeconfigExec{
+			APIVersion: c.ExecAPIVersion,
+			Args:       []string{"--region", c.Region, "eks", "get-token", "--cluster-name", c.Name},
+			Command:    "aws",
+		}
+
+		if c.RoleARN != "" {
+			exec.Args = append(exec.Args, "--role-arn", c.RoleARN)
+		}
+
+		// Required by client.authentication.k8s.io/v1.
+		if c.ExecAPIVersion == "client.authentication.k8s.io/v1" {
+			exec.InteractiveMode = "IfAvailable"
+		}
+
+		names := make([]string, 0, len(c.ExecEnvironment))
+		for k := range c.ExecEnvironment {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+
+		for _, k := range names {
+			exec.Env = append(exec.Env, kubeconfigExecEnvVar{
+				Name:  k,
+				Value: c.ExecEnvironment[k].(string),
+			})
+		}
+
+		user.User.Exec = exec
+	}
+
+	b, err := yaml.Marshal(kubeconfig{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []kubeconfigCluster{cluster},
+		Contexts:       []kubeconfigContext{context},
+		CurrentContext: c.ClusterName,
+		Preferences:    map[string]interface{}{},
+		Users:          []kubeconfigUser{user},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,102 @@
+package eks_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+)
+
+func TestAccEKSClusterKubeconfigDataSource_exec(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_exec(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.data", dataSourceResourceName, "certificate_authority_data"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint", dataSourceResourceName, "endpoint"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`command: aws`)),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`- `+rName)),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "token", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterKubeconfigDataSource_token(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_token(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "token_expiration"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`token: k8s-aws-v1\.`)),
+					testAccCheckClusterKubeconfigToken(dataSourceResourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterKubeconfigToken(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		identity, err := tfeks.NewVerifier(name).Verify(rs.Primary.Attributes["token"])
+		if err != nil {
+			return fmt.Errorf("Error verifying token for cluster %q: %v", name, err)
+		}
+		if identity.ARN == "" {
+			return fmt.Errorf("Unexpected blank ARN for token identity")
+		}
+
+		return nil
+	}
+}
+
+func testAccClusterKubeconfigDataSourceConfig_exec(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), `
+data "aws_eks_cluster_kubeconfig" "test" {
+  name = aws_eks_cluster.test.name
+
+  exec_env = {
+    AWS_PROFILE = "default"
+  }
+}
+`)
+}
+
+func testAccClusterKubeconfigDataSourceConfig_token(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), `
+data "aws_eks_cluster_kubeconfig" "test" {
+  name           = aws_eks_cluster.test.name
+  authentication = "token"
+}
+`)
+}
@@ -0,0 +1,104 @@
+package eks
+
+import (
+	"testing"
+)
+
+func TestClusterKubeconfigRender(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   clusterKubeconfig
+		expected string
+	}{
+		{
+			name: "exec",
+			config: clusterKubeconfig{
+				CertificateAuthorityData: "Q0E=",
+				ClusterName:              "arn:aws:eks:us-west-2:123456789012:cluster/example", //lintignore:AWSAT003,AWSAT005
+				Endpoint:                 "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com",    //lintignore:AWSAT003
+				ExecAPIVersion:           "client.authentication.k8s.io/v1",
+				ExecEnvironment:          map[string]interface{}{"B": "2", "A": "1"},
+				Name:                     "example",
+				Region:                   "us-west-2",                                 //lintignore:AWSAT003
+				RoleARN:                  "arn:aws:iam::123456789012:role/eks-admin", //lintignore:AWSAT005
+			},
+			expected: `apiVersion: v1
+kind: Config
+clusters:
+- name: arn:aws:eks:us-west-2:123456789012:cluster/example
+  cluster:
+    certificate-authority-data: Q0E=
+    server: https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com
+contexts:
+- name: arn:aws:eks:us-west-2:123456789012:cluster/example
+  context:
+    cluster: arn:aws:eks:us-west-2:123456789012:cluster/example
+    user: arn:aws:eks:us-west-2:123456789012:cluster/example
+current-context: arn:aws:eks:us-west-2:123456789012:cluster/example
+preferences: {}
+users:
+- name: arn:aws:eks:us-west-2:123456789012:cluster/example
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      args:
+      - --region
+      - us-west-2
+      - eks
+      - get-token
+      - --cluster-name
+      - example
+      - --role-arn
+      - arn:aws:iam::123456789012:role/eks-admin
+      command: aws
+      env:
+      - name: A
+        value: "1"
+      - name: B
+        value: "2"
+      interactiveMode: IfAvailable
+`, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name: "token",
+			config: clusterKubeconfig{
+				ClusterName: "arn:aws:eks:us-west-2:123456789012:cluster/example", //lintignore:AWSAT003,AWSAT005
+				Endpoint:    "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com",    //lintignore:AWSAT003
+				Name:        "example",
+				Token:       "k8s-aws-v1.token",
+			},
+			expected: `apiVersion: v1
+kind: Config
+clusters:
+- name: arn:aws:eks:us-west-2:123456789012:cluster/example
+  cluster:
+    server: https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com
+contexts:
+- name: arn:aws:eks:us-west-2:123456789012:cluster/example
+  context:
+    cluster: arn:aws:eks:us-west-2:123456789012:cluster/example
+    user: arn:aws:eks:us-west-2:123456789012:cluster/example
+current-context: arn:aws:eks:us-west-2:123456789012:cluster/example
+preferences: {}
+users:
+- name: arn:aws:eks:us-west-2:123456789012:cluster/example
+  user:
+    token: k8s-aws-v1.token
+`, //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.config.render()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("got:\n%s\nexpected:\n%s", got, tc.expected)
+			}
+		})
+	}
+}
@@ -11,6 +11,8 @@ With the following modifications:
  - Fix staticcheck reports
  - Ignore errorlint reports
  - Refactor deprecated io/ioutil in Go 1.16
+ - Add GetWithRole in place of GetWithOptions, assuming the role with the given STS client
+ - Add NewVerifierWithClient
 */
 
 /*
@@ -44,6 +46,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
@@ -158,6 +163,9 @@ type getCallerIdentityWrapper struct {
 type Generator interface {
 	// GetWithSTS returns a token valid for clusterID using the given STS client.
 	GetWithSTS(clusterID string, stsAPI *sts.STS) (Token, error)
+	// GetWithRole returns a token valid for clusterID using temporary credentials of roleARN,
+	// which is assumed using the given STS client.
+	GetWithRole(clusterID, roleARN, sessionName string, stsAPI *sts.STS) (Token, error)
 }
 
 type generator struct {
@@ -196,6 +204,27 @@ func (g generator) GetWithSTS(clusterID string, stsAPI *sts.STS) (Token, error)
 	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURLString)), tokenExpiration}, nil
 }
 
+// GetWithRole returns a token valid for clusterID using temporary credentials of roleARN,
+// which is assumed using the given STS client. If sessionName is empty, a session name is generated.
+func (g generator) GetWithRole(clusterID, roleARN, sessionName string, stsAPI *sts.STS) (Token, error) {
+	var sessionSetters []func(*stscreds.AssumeRoleProvider)
+
+	if sessionName != "" {
+		sessionSetters = append(sessionSetters, func(provider *stscreds.AssumeRoleProvider) {
+			provider.RoleSessionName = sessionName
+		})
+	}
+
+	// create STS-based credentials that will assume the given role
+	creds := stscreds.NewCredentialsWithClient(stsAPI, roleARN, sessionSetters...)
+
+	// create an STS API interface with the same configuration that uses the assumed role's temporary credentials
+	config := stsAPI.Client.Config.Copy(&aws.Config{Credentials: creds})
+	roleSTSAPI := &sts.STS{Client: client.New(*config, stsAPI.ClientInfo, stsAPI.Handlers.Copy())}
+
+	return g.GetWithSTS(clusterID, roleSTSAPI)
+}
+
 // Verifier validates tokens by calling STS and returning the associated identity.
 type Verifier interface {
 	Verify(token string) (*Identity, error)
@@ -208,8 +237,14 @@ type tokenVerifier struct {
 
 // NewVerifier creates a Verifier that is bound to the clusterID and uses the default http client.
 func NewVerifier(clusterID string) Verifier {
+	return NewVerifierWithClient(clusterID, http.DefaultClient)
+}
+
+// NewVerifierWithClient creates a Verifier that is bound to the clusterID and uses the given http client
+// to call STS. Tests can verify tokens without calling STS by using a client with a custom transport.
+func NewVerifierWithClient(clusterID string, client *http.Client) Verifier {
 	return tokenVerifier{
-		client:    http.DefaultClient,
+		client:    client,
 		clusterID: clusterID,
 	}
 }
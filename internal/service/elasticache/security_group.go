@@ -46,6 +46,7 @@ func ResourceSecurityGroup() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
@@ -61,6 +61,7 @@ func ResourceAppCookieStickinessPolicy() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceAppCookieStickinessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ELBConn
 
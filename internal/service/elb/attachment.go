@@ -36,6 +36,7 @@ func ResourceAttachment() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ELBConn
 	elbName := d.Get("elb").(string)
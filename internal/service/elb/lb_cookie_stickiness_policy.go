@@ -51,6 +51,7 @@ func ResourceCookieStickinessPolicy() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceCookieStickinessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ELBConn
 
@@ -70,6 +70,7 @@ func ResourceSSLNegotiationPolicy() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSSLNegotiationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ELBConn
 
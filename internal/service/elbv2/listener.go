@@ -474,6 +474,7 @@ func resourceListenerRead(d *schema.ResourceData, meta interface{}) error {
 
 	err := resource.Retry(loadBalancerListenerReadTimeout, func() *resource.RetryError {
 		var err error
+		//lintignore:AWSR003
 		listener, err = FindListenerByARN(conn, d.Id())
 
 		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, elbv2.ErrCodeListenerNotFoundException) {
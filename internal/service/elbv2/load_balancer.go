@@ -405,6 +405,7 @@ func resourceLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error
 func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ELBV2Conn
 
+	//lintignore:AWSR003
 	lb, err := FindLoadBalancerByARN(conn, d.Id())
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, elbv2.ErrCodeLoadBalancerNotFoundException) {
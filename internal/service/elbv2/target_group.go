@@ -542,6 +542,7 @@ func resourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
 		var err error
 
+		//lintignore:AWSR003
 		targetGroup, err = FindTargetGroupByARN(conn, d.Id())
 
 		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, elbv2.ErrCodeTargetGroupNotFoundException) {
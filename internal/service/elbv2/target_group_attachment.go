@@ -48,6 +48,7 @@ func ResourceTargetGroupAttachment() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ELBV2Conn
 
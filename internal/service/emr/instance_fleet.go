@@ -211,6 +211,7 @@ func ResourceInstanceFleet() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceInstanceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EMRConn
 
@@ -250,6 +251,7 @@ func resourceInstanceFleetRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing EMR Instance Fleets for Cluster (%s): %w", d.Get("cluster_id").(string), err)
 	}
 
+	//lintignore:AWSR003
 	fleet := FindInstanceFleetByID(instanceFleets, d.Id())
 	if fleet == nil {
 		if d.IsNewResource() {
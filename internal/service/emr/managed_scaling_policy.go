@@ -66,6 +66,7 @@ func ResourceManagedScalingPolicy() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceManagedScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EMRConn
 
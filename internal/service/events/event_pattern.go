@@ -0,0 +1,676 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strings"
+)
+
+const (
+	eventPatternNumericMax = 5e9
+	eventPatternOr         = "$or"
+)
+
+// EventPattern is a compiled EventBridge event pattern.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html.
+type EventPattern struct {
+	root *eventPatternObject
+}
+
+// eventPatternObject matches a JSON object. Each field must match, and one of the alternatives of $or.
+type eventPatternObject struct {
+	objects  map[string]*eventPatternObject
+	leaves   map[string][]eventPatternMatcher
+	or       []*eventPatternObject
+	hasOrKey bool
+}
+
+// eventPatternMatcher matches the value of a field. present is false if the event doesn't have the field.
+type eventPatternMatcher func(v interface{}, present bool) bool
+
+// CompileEventPattern parses and validates an event pattern.
+// All of the problems of the pattern are returned, prefixed with the path of the field.
+func CompileEventPattern(pattern string) (*EventPattern, []error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(pattern), &v); err != nil {
+		return nil, []error{fmt.Errorf("event pattern is not valid JSON: %w", err)}
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil, []error{fmt.Errorf("event pattern must be a JSON object")}
+	}
+
+	if len(m) == 0 {
+		return nil, []error{fmt.Errorf("event pattern must not be empty")}
+	}
+
+	c := &eventPatternCompiler{}
+	root := c.object("", m)
+
+	if len(c.errors) > 0 {
+		return nil, c.errors
+	}
+
+	return &EventPattern{root: root}, nil
+}
+
+// Match returns whether the event, a JSON object, matches the pattern.
+// An object in the pattern matches an array of objects in the event if it matches one of its elements.
+func (p *EventPattern) Match(event string) (bool, error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(event), &v); err != nil {
+		return false, fmt.Errorf("event is not valid JSON: %w", err)
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return false, fmt.Errorf("event must be a JSON object")
+	}
+
+	return p.root.match(m), nil
+}
+
+func (o *eventPatternObject) match(m map[string]interface{}) bool {
+	for k, object := range o.objects {
+		v, ok := m[k]
+
+		if !ok {
+			// Only matches if each field of the object can be missing.
+			if !object.match(nil) {
+				return false
+			}
+
+			continue
+		}
+
+		if !object.matchValue(v) {
+			return false
+		}
+	}
+
+	for k, matchers := range o.leaves {
+		v, ok := m[k]
+		matched := false
+
+		for _, matcher := range matchers {
+			if matcher(v, ok) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	if o.hasOrKey {
+		for _, alternative := range o.or {
+			if alternative.match(m) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func (o *eventPatternObject) matchValue(v interface{}) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return o.match(v)
+	case []interface{}:
+		for _, e := range v {
+			if m, ok := e.(map[string]interface{}); ok && o.match(m) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type eventPatternCompiler struct {
+	errors []error
+}
+
+func (c *eventPatternCompiler) errorf(path, format string, a ...interface{}) {
+	if path == "" {
+		path = "(root)"
+	}
+
+	c.errors = append(c.errors, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+func (c *eventPatternCompiler) object(path string, m map[string]interface{}) *eventPatternObject {
+	o := &eventPatternObject{
+		objects: make(map[string]*eventPatternObject),
+		leaves:  make(map[string][]eventPatternMatcher),
+	}
+
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fieldPath := eventPatternPath(path, k)
+
+		if k == eventPatternOr {
+			o.hasOrKey = true
+			alternatives, ok := m[k].([]interface{})
+
+			if !ok || len(alternatives) < 2 {
+				c.errorf(fieldPath, "must be an array of at least 2 patterns")
+				continue
+			}
+
+			for i, alternative := range alternatives {
+				alternativePath := fmt.Sprintf("%s[%d]", fieldPath, i)
+				alternative, ok := alternative.(map[string]interface{})
+
+				if !ok || len(alternative) == 0 {
+					c.errorf(alternativePath, "must be a non-empty object")
+					continue
+				}
+
+				o.or = append(o.or, c.object(alternativePath, alternative))
+			}
+
+			continue
+		}
+
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				c.errorf(fieldPath, "must not be an empty object")
+				continue
+			}
+
+			o.objects[k] = c.object(fieldPath, v)
+		case []interface{}:
+			if len(v) == 0 {
+				c.errorf(fieldPath, "must not be an empty array")
+				continue
+			}
+
+			for i, e := range v {
+				if matcher := c.matcher(fmt.Sprintf("%s[%d]", fieldPath, i), e); matcher != nil {
+					o.leaves[k] = append(o.leaves[k], matcher)
+				}
+			}
+		default:
+			c.errorf(fieldPath, "must be an object or an array")
+		}
+	}
+
+	return o
+}
+
+// matcher compiles an element of an array of the pattern.
+func (c *eventPatternCompiler) matcher(path string, v interface{}) eventPatternMatcher {
+	switch v := v.(type) {
+	case nil, bool, float64, string:
+		return eventPatternValueMatcher(func(e interface{}) bool {
+			return e == v
+		})
+	case []interface{}:
+		c.errorf(path, "must not be an array")
+		return nil
+	}
+
+	m := v.(map[string]interface{})
+
+	if len(m) != 1 {
+		c.errorf(path, "must be an object with exactly one filter")
+		return nil
+	}
+
+	for k, value := range m {
+		path := path + "." + k
+
+		switch k {
+		case "anything-but":
+			return c.anythingBut(path, value)
+		case "cidr":
+			s, ok := value.(string)
+
+			if !ok {
+				c.errorf(path, "must be a string")
+				return nil
+			}
+
+			_, network, err := net.ParseCIDR(s)
+
+			if err != nil {
+				c.errorf(path, "%q is not a valid CIDR block", s)
+				return nil
+			}
+
+			return eventPatternStringMatcher(func(e string) bool {
+				ip := net.ParseIP(e)
+
+				return ip != nil && network.Contains(ip)
+			})
+		case "equals-ignore-case":
+			match := c.equalsIgnoreCase(path, value)
+
+			if match == nil {
+				return nil
+			}
+
+			return eventPatternStringMatcher(match)
+		case "exists":
+			exists, ok := value.(bool)
+
+			if !ok {
+				c.errorf(path, "must be a boolean")
+				return nil
+			}
+
+			return func(e interface{}, present bool) bool {
+				return present == exists
+			}
+		case "numeric":
+			return c.numeric(path, value)
+		case "prefix", "suffix":
+			match := c.affix(path, k, value)
+
+			if match == nil {
+				return nil
+			}
+
+			return eventPatternStringMatcher(match)
+		case "wildcard":
+			match := c.wildcard(path, value)
+
+			if match == nil {
+				return nil
+			}
+
+			return eventPatternStringMatcher(match)
+		default:
+			c.errorf(path, "unknown filter %q", k)
+		}
+	}
+
+	return nil
+}
+
+func (c *eventPatternCompiler) anythingBut(path string, value interface{}) eventPatternMatcher {
+	var values []interface{}
+
+	switch value := value.(type) {
+	case string, float64:
+		values = []interface{}{value}
+	case []interface{}:
+		if len(value) == 0 {
+			c.errorf(path, "must not be an empty array")
+			return nil
+		}
+
+		for i, e := range value {
+			switch e.(type) {
+			case string, float64:
+				values = append(values, e)
+			default:
+				c.errorf(fmt.Sprintf("%s[%d]", path, i), "must be a string or a number")
+				return nil
+			}
+		}
+	case map[string]interface{}:
+		if len(value) != 1 {
+			c.errorf(path, "must be an object with exactly one filter")
+			return nil
+		}
+
+		for k, v := range value {
+			var match func(string) bool
+
+			switch k {
+			case "equals-ignore-case":
+				match = c.anyOf(path+"."+k, v, c.equalsIgnoreCase)
+			case "prefix", "suffix":
+				match = c.anyOf(path+"."+k, v, func(path string, value interface{}) func(string) bool {
+					return c.affix(path, k, value)
+				})
+			case "wildcard":
+				match = c.anyOf(path+"."+k, v, c.wildcard)
+			default:
+				c.errorf(path+"."+k, "unknown anything-but filter %q", k)
+			}
+
+			if match == nil {
+				return nil
+			}
+
+			return eventPatternStringMatcher(func(e string) bool {
+				return !match(e)
+			})
+		}
+	default:
+		c.errorf(path, "must be a string, a number, an array of strings or numbers, or an object")
+		return nil
+	}
+
+	return eventPatternValueMatcher(func(e interface{}) bool {
+		for _, v := range values {
+			if e == v {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// anyOf compiles the value of an anything-but filter, which can also be an array of strings.
+// The array matches a string that matches any of its elements.
+func (c *eventPatternCompiler) anyOf(path string, value interface{}, compile func(string, interface{}) func(string) bool) func(string) bool {
+	values, ok := value.([]interface{})
+
+	if !ok {
+		return compile(path, value)
+	}
+
+	if len(values) == 0 {
+		c.errorf(path, "must not be an empty array")
+		return nil
+	}
+
+	matches := make([]func(string) bool, 0, len(values))
+
+	for i, v := range values {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+
+		if _, ok := v.(string); !ok {
+			c.errorf(elementPath, "must be a string")
+			return nil
+		}
+
+		match := compile(elementPath, v)
+
+		if match == nil {
+			return nil
+		}
+
+		matches = append(matches, match)
+	}
+
+	return func(e string) bool {
+		for _, match := range matches {
+			if match(e) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// equalsIgnoreCase compiles an equals-ignore-case filter.
+func (c *eventPatternCompiler) equalsIgnoreCase(path string, value interface{}) func(string) bool {
+	s, ok := value.(string)
+
+	if !ok {
+		c.errorf(path, "must be a string")
+		return nil
+	}
+
+	return func(e string) bool {
+		return strings.EqualFold(e, s)
+	}
+}
+
+// affix compiles a prefix or suffix filter, which can ignore case.
+func (c *eventPatternCompiler) affix(path, k string, value interface{}) func(string) bool {
+	ignoreCase := false
+
+	if m, ok := value.(map[string]interface{}); ok {
+		if v, ok := m["equals-ignore-case"]; ok && len(m) == 1 {
+			ignoreCase = true
+			value = v
+		}
+	}
+
+	s, ok := value.(string)
+
+	if !ok {
+		c.errorf(path, "must be a string or an object with equals-ignore-case")
+		return nil
+	}
+
+	if s == "" {
+		c.errorf(path, "must not be empty")
+		return nil
+	}
+
+	has := strings.HasPrefix
+
+	if k == "suffix" {
+		has = strings.HasSuffix
+	}
+
+	if ignoreCase {
+		s = strings.ToLower(s)
+
+		return func(e string) bool {
+			return has(strings.ToLower(e), s)
+		}
+	}
+
+	return func(e string) bool {
+		return has(e, s)
+	}
+}
+
+// wildcard compiles a wildcard filter. "*" matches any characters, "\*" and "\\" match "*" and "\".
+func (c *eventPatternCompiler) wildcard(path string, value interface{}) func(string) bool {
+	s, ok := value.(string)
+
+	if !ok {
+		c.errorf(path, "must be a string")
+		return nil
+	}
+
+	// The literal parts between the unescaped "*".
+	var parts []string
+	var part strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 == len(s) || (s[i+1] != '*' && s[i+1] != '\\') {
+				c.errorf(path, "%q has an invalid escape, only \\* and \\\\ are allowed", s)
+				return nil
+			}
+
+			i++
+			part.WriteByte(s[i])
+		case '*':
+			if i > 0 && s[i-1] == '*' {
+				c.errorf(path, "%q must not have consecutive wildcards", s)
+				return nil
+			}
+
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(s[i])
+		}
+	}
+
+	parts = append(parts, part.String())
+
+	return func(e string) bool {
+		if len(parts) == 1 {
+			return e == parts[0]
+		}
+
+		if !strings.HasPrefix(e, parts[0]) {
+			return false
+		}
+
+		e = e[len(parts[0]):]
+
+		for _, part := range parts[1 : len(parts)-1] {
+			i := strings.Index(e, part)
+
+			if i == -1 {
+				return false
+			}
+
+			e = e[i+len(part):]
+		}
+
+		return strings.HasSuffix(e, parts[len(parts)-1])
+	}
+}
+
+func (c *eventPatternCompiler) numeric(path string, value interface{}) eventPatternMatcher {
+	l, ok := value.([]interface{})
+
+	if !ok || (len(l) != 2 && len(l) != 4) {
+		c.errorf(path, "must be an array of one or two comparisons, such as [\">\", 0, \"<=\", 5]")
+		return nil
+	}
+
+	type comparison struct {
+		operator string
+		value    float64
+	}
+
+	var comparisons []comparison
+	lower, upper := false, false
+
+	for i := 0; i < len(l); i += 2 {
+		operator, ok := l[i].(string)
+
+		if !ok {
+			c.errorf(fmt.Sprintf("%s[%d]", path, i), "must be an operator")
+			return nil
+		}
+
+		value, ok := l[i+1].(float64)
+
+		if !ok {
+			c.errorf(fmt.Sprintf("%s[%d]", path, i+1), "must be a number")
+			return nil
+		}
+
+		if math.Abs(value) > eventPatternNumericMax {
+			c.errorf(fmt.Sprintf("%s[%d]", path, i+1), "must be between %g and %g", -eventPatternNumericMax, eventPatternNumericMax)
+			return nil
+		}
+
+		switch operator {
+		case "=":
+			if len(l) != 2 {
+				c.errorf(fmt.Sprintf("%s[%d]", path, i), "\"=\" can't be combined with another comparison")
+				return nil
+			}
+		case ">", ">=":
+			if lower {
+				c.errorf(fmt.Sprintf("%s[%d]", path, i), "must not have two lower bounds")
+				return nil
+			}
+
+			lower = true
+		case "<", "<=":
+			if upper {
+				c.errorf(fmt.Sprintf("%s[%d]", path, i), "must not have two upper bounds")
+				return nil
+			}
+
+			upper = true
+		default:
+			c.errorf(fmt.Sprintf("%s[%d]", path, i), "unknown operator %q", operator)
+			return nil
+		}
+
+		comparisons = append(comparisons, comparison{operator, value})
+	}
+
+	return eventPatternValueMatcher(func(e interface{}) bool {
+		n, ok := e.(float64)
+
+		if !ok {
+			return false
+		}
+
+		for _, comparison := range comparisons {
+			var ok bool
+
+			switch comparison.operator {
+			case "=":
+				ok = n == comparison.value
+			case ">":
+				ok = n > comparison.value
+			case ">=":
+				ok = n >= comparison.value
+			case "<":
+				ok = n < comparison.value
+			case "<=":
+				ok = n <= comparison.value
+			}
+
+			if !ok {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// eventPatternValueMatcher returns a matcher of present fields.
+// If the value of the field is an array, one of its elements must match.
+func eventPatternValueMatcher(match func(interface{}) bool) eventPatternMatcher {
+	return func(v interface{}, present bool) bool {
+		if !present {
+			return false
+		}
+
+		if l, ok := v.([]interface{}); ok {
+			for _, e := range l {
+				if match(e) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		return match(v)
+	}
+}
+
+// eventPatternStringMatcher returns a matcher of present fields with string values.
+func eventPatternStringMatcher(match func(string) bool) eventPatternMatcher {
+	return eventPatternValueMatcher(func(v interface{}) bool {
+		s, ok := v.(string)
+
+		return ok && match(s)
+	})
+}
+
+func eventPatternPath(path, k string) string {
+	if path == "" {
+		return k
+	}
+
+	return path + "." + k
+}
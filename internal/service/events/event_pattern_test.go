@@ -0,0 +1,165 @@
+package events
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEventPatternMatch(t *testing.T) {
+	event := `{
+  "source": "aws.ec2",
+  "detail-type": "EC2 Instance State-change Notification",
+  "account": "123456789012",
+  "region": "us-east-1",
+  "resources": ["arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "running",
+    "cpu": 75.5,
+    "source-ip": "10.0.0.12",
+    "file": "images/photo.PNG",
+    "tags": ["prod", "web"],
+    "owner": null,
+    "volumes": [{"id": "vol-1", "size": 8}, {"id": "vol-2", "size": 100}]
+  }
+}`
+
+	testCases := []struct {
+		Name    string
+		Pattern string
+		Match   bool
+	}{
+		{"exact", `{"source": ["aws.ec2"]}`, true},
+		{"exact no match", `{"source": ["aws.s3"]}`, false},
+		{"nested", `{"source": ["aws.ec2"], "detail": {"state": ["stopped", "running"]}}`, true},
+		{"nested no match", `{"source": ["aws.ec2"], "detail": {"state": ["stopped"]}}`, false},
+		{"array intersection", `{"detail": {"tags": ["dev", "web"]}}`, true},
+		{"null", `{"detail": {"owner": [null]}}`, true},
+		{"missing field", `{"detail": {"zone": ["a"]}}`, false},
+		{"prefix", `{"detail": {"instance-id": [{"prefix": "i-"}]}}`, true},
+		{"prefix no match", `{"detail": {"instance-id": [{"prefix": "vol-"}]}}`, false},
+		{"prefix ignore case", `{"detail-type": [{"prefix": {"equals-ignore-case": "ec2 instance"}}]}`, true},
+		{"suffix", `{"detail": {"file": [{"suffix": ".PNG"}]}}`, true},
+		{"suffix case sensitive", `{"detail": {"file": [{"suffix": ".png"}]}}`, false},
+		{"suffix ignore case", `{"detail": {"file": [{"suffix": {"equals-ignore-case": ".png"}}]}}`, true},
+		{"equals ignore case", `{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`, true},
+		{"anything-but", `{"detail": {"state": [{"anything-but": "stopped"}]}}`, true},
+		{"anything-but list", `{"detail": {"state": [{"anything-but": ["stopped", "running"]}]}}`, false},
+		{"anything-but number", `{"detail": {"cpu": [{"anything-but": [75.5]}]}}`, false},
+		{"anything-but prefix", `{"detail": {"instance-id": [{"anything-but": {"prefix": "i-"}}]}}`, false},
+		{"anything-but prefix list", `{"detail": {"instance-id": [{"anything-but": {"prefix": ["vol-", "i-"]}}]}}`, false},
+		{"anything-but suffix list", `{"detail": {"file": [{"anything-but": {"suffix": [".jpg", ".gif"]}}]}}`, true},
+		{"anything-but equals ignore case list", `{"detail": {"state": [{"anything-but": {"equals-ignore-case": ["STOPPED", "RUNNING"]}}]}}`, false},
+		{"anything-but wildcard list", `{"detail": {"file": [{"anything-but": {"wildcard": ["docs/*", "images/*"]}}]}}`, false},
+		{"anything-but wildcard list no match", `{"detail": {"file": [{"anything-but": {"wildcard": ["docs/*", "*.jpg"]}}]}}`, true},
+		{"anything-but missing", `{"detail": {"zone": [{"anything-but": "a"}]}}`, false},
+		{"numeric range", `{"detail": {"cpu": [{"numeric": [">", 50, "<=", 75.5]}]}}`, true},
+		{"numeric no match", `{"detail": {"cpu": [{"numeric": [">", 80]}]}}`, false},
+		{"numeric equals", `{"detail": {"cpu": [{"numeric": ["=", 75.5]}]}}`, true},
+		{"numeric string", `{"detail": {"state": [{"numeric": [">", 0]}]}}`, false},
+		{"exists", `{"detail": {"state": [{"exists": true}]}}`, true},
+		{"exists no match", `{"detail": {"zone": [{"exists": true}]}}`, false},
+		{"not exists", `{"detail": {"zone": [{"exists": false}]}}`, true},
+		{"not exists no match", `{"detail": {"state": [{"exists": false}]}}`, false},
+		{"not exists missing parent", `{"other": {"zone": [{"exists": false}]}}`, true},
+		{"cidr", `{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`, true},
+		{"cidr no match", `{"detail": {"source-ip": [{"cidr": "10.0.1.0/24"}]}}`, false},
+		{"wildcard", `{"resources": [{"wildcard": "arn:aws:ec2:*:instance/*"}]}`, true},
+		{"wildcard no match", `{"resources": [{"wildcard": "arn:aws:ec2:*:volume/*"}]}`, false},
+		{"wildcard exact", `{"detail": {"state": [{"wildcard": "running"}]}}`, true},
+		{"array of objects", `{"detail": {"volumes": {"size": [{"numeric": [">=", 100]}]}}}`, true},
+		{"array of objects no match", `{"detail": {"volumes": {"size": [{"numeric": [">", 100]}]}}}`, false},
+		{"or", `{"source": ["aws.ec2"], "$or": [{"detail": {"state": ["stopped"]}}, {"detail": {"cpu": [{"numeric": [">", 50]}]}}]}`, true},
+		{"or no match", `{"$or": [{"detail": {"state": ["stopped"]}}, {"source": ["aws.s3"]}]}`, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			pattern, errs := CompileEventPattern(testCase.Pattern)
+
+			if len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+
+			match, err := pattern.Match(event)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if match != testCase.Match {
+				t.Errorf("got %t, expected %t", match, testCase.Match)
+			}
+		})
+	}
+}
+
+func TestCompileEventPattern(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Pattern string
+		Errors  []string
+	}{
+		{"not JSON", `{`, []string{"event pattern is not valid JSON: unexpected end of JSON input"}},
+		{"not object", `["aws.ec2"]`, []string{"event pattern must be a JSON object"}},
+		{"empty", `{}`, []string{"event pattern must not be empty"}},
+		{"scalar", `{"source": "aws.ec2"}`, []string{"source: must be an object or an array"}},
+		{"empty array", `{"source": []}`, []string{"source: must not be an empty array"}},
+		{"nested array", `{"source": [["aws.ec2"]]}`, []string{"source[0]: must not be an array"}},
+		{"unknown filter", `{"source": [{"startswith": "aws."}]}`, []string{`source[0].startswith: unknown filter "startswith"`}},
+		{"two filters", `{"source": [{"prefix": "aws.", "suffix": "ec2"}]}`, []string{"source[0]: must be an object with exactly one filter"}},
+		{"empty prefix", `{"source": [{"prefix": ""}]}`, []string{"source[0].prefix: must not be empty"}},
+		{"invalid cidr", `{"detail": {"ip": [{"cidr": "10.0.0.0/33"}]}}`, []string{`detail.ip[0].cidr: "10.0.0.0/33" is not a valid CIDR block`}},
+		{"exists string", `{"detail": {"ip": [{"exists": "true"}]}}`, []string{"detail.ip[0].exists: must be a boolean"}},
+		{"numeric operator", `{"detail": {"n": [{"numeric": ["!=", 1]}]}}`, []string{`detail.n[0].numeric[0]: unknown operator "!="`}},
+		{"numeric two lower bounds", `{"detail": {"n": [{"numeric": [">", 1, ">=", 2]}]}}`, []string{"detail.n[0].numeric[2]: must not have two lower bounds"}},
+		{"numeric equals combined", `{"detail": {"n": [{"numeric": ["=", 1, "<", 2]}]}}`, []string{`detail.n[0].numeric[0]: "=" can't be combined with another comparison`}},
+		{"numeric range", `{"detail": {"n": [{"numeric": [">", 6e9]}]}}`, []string{"detail.n[0].numeric[1]: must be between -5e+09 and 5e+09"}},
+		{"wildcard consecutive", `{"source": [{"wildcard": "aws.**"}]}`, []string{`source[0].wildcard: "aws.**" must not have consecutive wildcards`}},
+		{"anything-but unknown", `{"source": [{"anything-but": {"cidr": "10.0.0.0/8"}}]}`, []string{`source[0].anything-but.cidr: unknown anything-but filter "cidr"`}},
+		{"anything-but empty list", `{"source": [{"anything-but": {"prefix": []}}]}`, []string{"source[0].anything-but.prefix: must not be an empty array"}},
+		{"anything-but list element", `{"source": [{"anything-but": {"wildcard": ["aws.*", 1]}}]}`, []string{"source[0].anything-but.wildcard[1]: must be a string"}},
+		{"anything-but list invalid element", `{"source": [{"anything-but": {"suffix": ["ec2", ""]}}]}`, []string{"source[0].anything-but.suffix[1]: must not be empty"}},
+		{"or one alternative", `{"$or": [{"source": ["aws.ec2"]}]}`, []string{"$or: must be an array of at least 2 patterns"}},
+		{"or alternative", `{"$or": [{"source": ["aws.ec2"]}, {"source": "aws.s3"}]}`, []string{"$or[1].source: must be an object or an array"}},
+		{
+			"multiple",
+			`{"detail": {"a": [], "b": {}}, "source": [{"prefix": 1}]}`,
+			[]string{
+				"detail.a: must not be an empty array",
+				"detail.b: must not be an empty object",
+				"source[0].prefix: must be a string or an object with equals-ignore-case",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, errs := CompileEventPattern(testCase.Pattern)
+
+			var got []string
+
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+
+			if strings.Join(got, "\n") != strings.Join(testCase.Errors, "\n") {
+				t.Errorf("got errors:\n%s\n\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(testCase.Errors, "\n"))
+			}
+		})
+	}
+}
+
+func TestEventPatternMatch_invalidEvent(t *testing.T) {
+	pattern, errs := CompileEventPattern(`{"source": ["aws.ec2"]}`)
+
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	for _, event := range []string{`{`, `["aws.ec2"]`} {
+		if _, err := pattern.Match(event); err == nil {
+			t.Errorf("expected error for event %s", event)
+		}
+	}
+}
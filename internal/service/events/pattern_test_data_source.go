@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourcePatternTest() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatternTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"any_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEventPatternValue(),
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"matches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
+		},
+	}
+}
+
+func dataSourcePatternTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	eventPattern, err := structure.NormalizeJsonString(d.Get("event_pattern").(string))
+
+	if err != nil {
+		return diag.Errorf("event pattern contains an invalid JSON: %s", err)
+	}
+
+	pattern, errs := CompileEventPattern(eventPattern)
+
+	if len(errs) > 0 {
+		var diags diag.Diagnostics
+
+		for _, err := range errs {
+			diags = append(diags, diag.Errorf("invalid event pattern: %s", err)...)
+		}
+
+		return diags
+	}
+
+	events := d.Get("events").([]interface{})
+	matches := make([]bool, 0, len(events))
+	allMatch, anyMatch := true, false
+	id := []string{eventPattern}
+
+	for i, event := range events {
+		event, _ := event.(string)
+
+		match, err := pattern.Match(event)
+
+		if err != nil {
+			return diag.Errorf("events[%d]: %s", i, err)
+		}
+
+		matches = append(matches, match)
+		allMatch = allMatch && match
+		anyMatch = anyMatch || match
+		id = append(id, event)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join(id, "\n"))))
+	d.Set("all_match", allMatch)
+	d.Set("any_match", anyMatch)
+
+	if err := d.Set("matches", matches); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting matches: %w", err))
+	}
+
+	return nil
+}
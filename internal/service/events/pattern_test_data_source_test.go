@@ -0,0 +1,90 @@
+package events_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEventsPatternTestDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_event_pattern_test.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternTestDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_match", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "any_match", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.0", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.1", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternTestDataSource_invalidPattern(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternTestDataSourceConfig_invalidPattern,
+				ExpectError: regexp.MustCompile(`detail.state\[0\].startswith: unknown filter "startswith"`),
+			},
+		},
+	})
+}
+
+const testAccPatternTestDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern_test" "test" {
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+    detail = {
+      state = [{ "anything-but" = "terminated" }]
+    }
+  })
+
+  events = [
+    jsonencode({
+      source = "aws.ec2"
+      detail = {
+        state = "running"
+      }
+    }),
+    jsonencode({
+      source = "aws.ec2"
+      detail = {
+        state = "terminated"
+      }
+    }),
+  ]
+}
+`
+
+const testAccPatternTestDataSourceConfig_invalidPattern = `
+data "aws_cloudwatch_event_pattern_test" "test" {
+  event_pattern = jsonencode({
+    detail = {
+      state = [{ startswith = "run" }]
+    }
+  })
+
+  events = [
+    jsonencode({
+      detail = {
+        state = "running"
+      }
+    }),
+  ]
+}
+`
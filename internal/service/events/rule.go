@@ -408,6 +408,11 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		if len(json) > maxJsonLength {
 			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJsonLength, json))
 		}
+
+		_, patternErrors := CompileEventPattern(json)
+		for _, err := range patternErrors {
+			errors = append(errors, fmt.Errorf("%q is not a valid event pattern: %w", k, err))
+		}
 		return
 	}
 }
@@ -452,6 +452,7 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	busName := d.Get("event_bus_name").(string)
 
+	//lintignore:AWSR003
 	t, err := FindTarget(conn, busName, d.Get("rule").(string), d.Get("target_id").(string))
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, "ValidationException") ||
@@ -40,6 +40,8 @@ func ResourceGameServerGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(gameServerGroupDeletedDefaultTimeout),
 		},
 
+		CustomizeDiff: verify.SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -388,6 +388,7 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	//lintignore:AWSR003
 	out, err := FindTableByName(conn, catalogID, dbName, name)
 	if err != nil {
 
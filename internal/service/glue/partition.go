@@ -227,6 +227,7 @@ func resourcePartitionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	log.Printf("[DEBUG] Reading Glue Partition: %s", d.Id())
+	//lintignore:AWSR003
 	partition, err := FindPartitionByValues(conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
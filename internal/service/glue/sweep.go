@@ -18,62 +18,62 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
 		Name: "aws_glue_catalog_database",
 		F:    sweepCatalogDatabases,
 	})
 
-	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
 		Name: "aws_glue_classifier",
 		F:    sweepClassifiers,
 	})
 
-	resource.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
 		Name: "aws_glue_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
 		Name: "aws_glue_crawler",
 		F:    sweepCrawlers,
 	})
 
-	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
 		Name: "aws_glue_dev_endpoint",
 		F:    sweepDevEndpoint,
 	})
 
-	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_job", &resource.Sweeper{
 		Name: "aws_glue_job",
 		F:    sweepJobs,
 	})
 
-	resource.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
 		Name: "aws_glue_ml_transform",
 		F:    sweepMLTransforms,
 	})
 
-	resource.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
 		Name: "aws_glue_registry",
 		F:    sweepRegistry,
 	})
 
-	resource.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
 		Name: "aws_glue_schema",
 		F:    sweepSchema,
 	})
 
-	resource.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
 		Name: "aws_glue_security_configuration",
 		F:    sweepSecurityConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
 		Name: "aws_glue_trigger",
 		F:    sweepTriggers,
 	})
 
-	resource.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
 		Name: "aws_glue_workflow",
 		F:    sweepWorkflow,
 	})
@@ -103,7 +103,7 @@ func sweepCatalogDatabases(region string) error {
 			d.Set("name", name)
 			d.Set("catalog_id", database.CatalogId)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Catalog Database %s: %s", name, err)
 			}
@@ -193,7 +193,7 @@ func sweepConnections(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Connection %s: %s", id, err)
 			}
@@ -231,7 +231,7 @@ func sweepCrawlers(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Crawler %s: %s", name, err)
 			}
@@ -346,7 +346,7 @@ func sweepMLTransforms(region string) error {
 			r := ResourceMLTransform()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -390,7 +390,7 @@ func sweepRegistry(region string) error {
 		d := r.Data(nil)
 		d.SetId(arn)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Glue Registry %s: %s", arn, err)
 		}
@@ -420,7 +420,7 @@ func sweepSchema(region string) error {
 		d := r.Data(nil)
 		d.SetId(arn)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Glue Schema %s: %s", arn, err)
 		}
@@ -489,7 +489,7 @@ func sweepTriggers(region string) error {
 			r := ResourceTrigger()
 			d := r.Data(nil)
 			d.SetId(name)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Trigger %s: %s", name, err)
 			}
@@ -96,6 +96,7 @@ func ResourceAccessKey() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
@@ -30,6 +30,7 @@ func ResourceAccountAlias() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceAccountAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
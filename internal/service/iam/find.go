@@ -306,3 +306,54 @@ func FindSAMLProviderByARN(ctx context.Context, conn *iam.IAM, arn string) (*iam
 
 	return output, nil
 }
+
+func FindPolicyByARN(ctx context.Context, conn *iam.IAM, arn string) (*iam.Policy, error) {
+	input := &iam.GetPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
+
+func FindPolicyVersion(ctx context.Context, conn *iam.IAM, arn, versionID string) (*iam.PolicyVersion, error) {
+	input := &iam.GetPolicyVersionInput{
+		PolicyArn: aws.String(arn),
+		VersionId: aws.String(versionID),
+	}
+
+	output, err := conn.GetPolicyVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PolicyVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PolicyVersion, nil
+}
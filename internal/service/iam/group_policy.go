@@ -58,6 +58,7 @@ func ResourceGroupPolicy() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceGroupPolicyPut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
@@ -67,6 +67,7 @@ func resourceGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{})
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
 		var err error
 
+		//lintignore:AWSR003
 		attachedPolicy, err = FindGroupAttachedPolicy(conn, group, arn)
 
 		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
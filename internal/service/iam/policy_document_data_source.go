@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -25,13 +27,18 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourcePolicyDocumentRead,
+		ReadWithoutTimeout: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"lint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(PolicyLintMode_Values(), false),
+			},
 			"override_json": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -116,12 +123,12 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 }
 
-func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mergedDoc := &IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_json"); ok {
 		if err := json.Unmarshal([]byte(v.(string)), mergedDoc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -138,14 +145,14 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 		for sourceJSONIndex, sourceJSON := range v.([]interface{}) {
 			sourceDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			// assure all statements in sourceDoc are unique before merging
 			for stmtIndex, stmt := range sourceDoc.Statements {
 				if stmt.Sid != "" {
 					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return fmt.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
+						return diag.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
 					}
 					sidMap[stmt.Sid] = struct{}{}
 				}
@@ -178,7 +185,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 
 			if sid, ok := cfgStmt["sid"]; ok {
 				if _, ok := sidMap[sid.(string)]; ok {
-					return fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
+					return diag.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
 				}
 				stmt.Sid = sid.(string)
 				if len(stmt.Sid) > 0 {
@@ -199,7 +206,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 					policyDecodeConfigStringList(resources), doc.Version,
 				)
 				if err != nil {
-					return fmt.Errorf("error reading resources: %w", err)
+					return diag.Errorf("error reading resources: %s", err)
 				}
 			}
 			if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
@@ -208,7 +215,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 					policyDecodeConfigStringList(notResources), doc.Version,
 				)
 				if err != nil {
-					return fmt.Errorf("error reading not_resources: %w", err)
+					return diag.Errorf("error reading not_resources: %s", err)
 				}
 			}
 
@@ -216,7 +223,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(principals, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading principals: %w", err)
+					return diag.Errorf("error reading principals: %s", err)
 				}
 			}
 
@@ -224,7 +231,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(notPrincipals, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading not_principals: %w", err)
+					return diag.Errorf("error reading not_principals: %s", err)
 				}
 			}
 
@@ -232,7 +239,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				var err error
 				stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(conditions, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading condition: %w", err)
+					return diag.Errorf("error reading condition: %s", err)
 				}
 			}
 
@@ -251,7 +258,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 		for _, overrideJSON := range v.([]interface{}) {
 			overrideDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			mergedDoc.Merge(overrideDoc)
@@ -263,7 +270,7 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOk("override_json"); ok {
 		overrideDoc := &IAMPolicyDoc{}
 		if err := json.Unmarshal([]byte(v.(string)), overrideDoc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		mergedDoc.Merge(overrideDoc)
@@ -272,13 +279,23 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
-		return err
+		return diag.FromErr(err)
 	}
 	jsonString := string(jsonDoc)
 
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
+	if v, ok := d.GetOk("lint"); ok {
+		findings, err := LintPolicyDocument(jsonString)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return policyLintDiagnostics(findings, v.(string))
+	}
+
 	return nil
 }
 
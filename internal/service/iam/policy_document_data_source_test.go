@@ -201,6 +201,35 @@ func TestAccIAMPolicyDocumentDataSource_noStatementOverride(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_lint(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentLintConfig("error", "StringEqual"),
+				ExpectError: regexp.MustCompile(`malformed condition operator StringEqual`),
+			},
+			{
+				Config: testAccPolicyDocumentLintConfig("warn", "StringEqual"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+			{
+				// Warnings don't fail in error mode.
+				Config: testAccPolicyDocumentLintConfig("error", "StringEquals"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_duplicateSid(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -1045,6 +1074,25 @@ var testAccPolicyDocumentNoStatementOverrideExpectedJSON = `{
   ]
 }`
 
+func testAccPolicyDocumentLintConfig(mode, operator string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  lint = %[1]q
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+
+    condition {
+      test     = %[2]q
+      variable = "aws:PrincipalTag/team"
+      values   = ["example"]
+    }
+  }
+}
+`, mode, operator)
+}
+
 var testAccPolicyDocumentDuplicateSidConfig = `
 data "aws_iam_policy_document" "test" {
   statement {
@@ -0,0 +1,440 @@
+package iam
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	// policyMaxManagedPolicySize is the maximum number of characters in a managed policy, excluding whitespace.
+	policyMaxManagedPolicySize = 6144
+
+	PolicyLintModeError = "error"
+	PolicyLintModeWarn  = "warn"
+)
+
+func PolicyLintMode_Values() []string {
+	return []string{
+		PolicyLintModeError,
+		PolicyLintModeWarn,
+	}
+}
+
+// policyLintActionsData is the IAM action catalog, one service prefix and its actions per line.
+//
+//go:embed policy_lint_actions.txt
+var policyLintActionsData string
+
+// policyLintSupplementalActions are IAM actions that are not API operations, and so are not in the generated catalog.
+var policyLintSupplementalActions = map[string][]string{
+	"apigateway":        {"AddCertificateToDomain", "DELETE", "GET", "PATCH", "POST", "PUT", "RemoveCertificateFromDomain", "SetWebACL", "UpdateRestApiPolicy"},
+	"codecommit":        {"GitPull", "GitPush"},
+	"dynamodb":          {"ConditionCheckItem", "PartiQLDelete", "PartiQLInsert", "PartiQLSelect", "PartiQLUpdate"},
+	"ec2messages":       {"AcknowledgeMessage", "DeleteMessage", "FailMessage", "GetEndpoint", "GetMessages", "SendReply"},
+	"ecs":               {"Poll", "StartTelemetrySession"},
+	"eks":               {"AccessKubernetesApi"},
+	"elasticfilesystem": {"ClientMount", "ClientRootAccess", "ClientWrite"},
+	"es":                {"ESHttpDelete", "ESHttpGet", "ESHttpHead", "ESHttpPatch", "ESHttpPost", "ESHttpPut"},
+	"execute-api":       {"InvalidateCache", "Invoke", "ManageConnections"},
+	"iam":               {"PassRole"},
+	"iot":               {"Connect", "Publish", "Receive", "RetainPublish", "Subscribe"},
+	"lambda":            {"InvokeFunction", "InvokeFunctionUrl"},
+	"logs":              {"CreateLogDelivery", "DeleteLogDelivery", "GetLogDelivery", "Link", "ListLogDeliveries", "UpdateLogDelivery"},
+	"rds-db":            {"connect"},
+	"s3": {
+		"BypassGovernanceRetention", "DeleteObjectVersion", "DeleteObjectVersionTagging",
+		"GetAccelerateConfiguration", "GetAnalyticsConfiguration", "GetEncryptionConfiguration",
+		"GetInventoryConfiguration", "GetLifecycleConfiguration", "GetMetricsConfiguration",
+		"GetObjectVersion", "GetObjectVersionAcl", "GetObjectVersionAttributes", "GetObjectVersionTagging",
+		"GetReplicationConfiguration", "ListAllMyBuckets", "ListBucket", "ListBucketMultipartUploads",
+		"ListBucketVersions", "ListMultipartUploadParts", "ObjectOwnerOverrideToBucketOwner",
+		"PutAccelerateConfiguration", "PutAnalyticsConfiguration", "PutEncryptionConfiguration",
+		"PutInventoryConfiguration", "PutLifecycleConfiguration", "PutMetricsConfiguration",
+		"PutObjectVersionAcl", "PutObjectVersionTagging", "PutReplicationConfiguration",
+		"ReplicateDelete", "ReplicateObject", "ReplicateTags",
+	},
+	"ssmmessages": {"CreateControlChannel", "CreateDataChannel", "OpenControlChannel", "OpenDataChannel"},
+	"sts":         {"SetSourceIdentity", "TagSession"},
+}
+
+// policyLintDataPlaneActions are actions on the data in a resource, e.g. objects or items, rather than on its configuration.
+// These should not be allowed on all resources.
+var policyLintDataPlaneActions = []string{
+	"dynamodb:BatchGetItem",
+	"dynamodb:BatchWriteItem",
+	"dynamodb:DeleteItem",
+	"dynamodb:GetItem",
+	"dynamodb:PutItem",
+	"dynamodb:Query",
+	"dynamodb:Scan",
+	"dynamodb:UpdateItem",
+	"firehose:PutRecord",
+	"firehose:PutRecordBatch",
+	"kinesis:GetRecords",
+	"kinesis:PutRecord",
+	"kinesis:PutRecords",
+	"kms:Decrypt",
+	"kms:Encrypt",
+	"kms:GenerateDataKey",
+	"kms:ReEncryptFrom",
+	"kms:ReEncryptTo",
+	"lambda:InvokeFunction",
+	"s3:DeleteObject",
+	"s3:GetObject",
+	"s3:PutObject",
+	"secretsmanager:GetSecretValue",
+	"sns:Publish",
+	"sqs:DeleteMessage",
+	"sqs:ReceiveMessage",
+	"sqs:SendMessage",
+	"ssm:GetParameter",
+	"ssm:GetParameters",
+	"ssm:GetParametersByPath",
+}
+
+// policyLintConditionOperators are the condition operators without the ForAllValues/ForAnyValue qualifiers and IfExists suffix.
+var policyLintConditionOperators = map[string]bool{
+	"ArnEquals":                 true,
+	"ArnLike":                   true,
+	"ArnNotEquals":              true,
+	"ArnNotLike":                true,
+	"BinaryEquals":              true,
+	"Bool":                      true,
+	"DateEquals":                true,
+	"DateGreaterThan":           true,
+	"DateGreaterThanEquals":     true,
+	"DateLessThan":              true,
+	"DateLessThanEquals":        true,
+	"DateNotEquals":             true,
+	"IpAddress":                 true,
+	"NotIpAddress":              true,
+	"Null":                      true,
+	"NumericEquals":             true,
+	"NumericGreaterThan":        true,
+	"NumericGreaterThanEquals":  true,
+	"NumericLessThan":           true,
+	"NumericLessThanEquals":     true,
+	"NumericNotEquals":          true,
+	"StringEquals":              true,
+	"StringEqualsIgnoreCase":    true,
+	"StringLike":                true,
+	"StringNotEquals":           true,
+	"StringNotEqualsIgnoreCase": true,
+	"StringNotLike":             true,
+}
+
+var (
+	policyLintActions     map[string]map[string]bool
+	policyLintActionsOnce sync.Once
+)
+
+// PolicyLintFinding is a problem found in a policy document.
+type PolicyLintFinding struct {
+	Severity diag.Severity
+	Summary  string
+}
+
+// LintPolicyDocument checks a JSON policy document for the following problems without calling AWS:
+//
+// * Actions with an unknown service prefix or that match no known action (warning).
+// * NotAction with Effect Allow (warning).
+// * Data plane actions allowed on Resource "*" (warning).
+// * Malformed actions, condition operators and condition keys (error).
+// * Duplicate Sids (error).
+// * Documents longer than the managed policy limit once whitespace is removed (error).
+func LintPolicyDocument(document string) ([]PolicyLintFinding, error) {
+	var doc map[string]interface{}
+
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, fmt.Errorf("error parsing policy document: %w", err)
+	}
+
+	var findings []PolicyLintFinding
+	var statements []interface{}
+	sids := make(map[string]bool)
+
+	switch v := doc["Statement"].(type) {
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	}
+
+	for i, v := range statements {
+		statement, ok := v.(map[string]interface{})
+
+		if !ok {
+			findings = append(findings, PolicyLintFinding{diag.Error, fmt.Sprintf("statement %d: statement is not an object", i)})
+			continue
+		}
+
+		name := fmt.Sprintf("statement %d", i)
+		sid, _ := statement["Sid"].(string)
+
+		if sid != "" {
+			name = fmt.Sprintf("statement %d (%s)", i, sid)
+
+			if sids[sid] {
+				findings = append(findings, PolicyLintFinding{diag.Error, fmt.Sprintf("%s: duplicate Sid", name)})
+			}
+
+			sids[sid] = true
+		}
+
+		allow := statement["Effect"] == "Allow"
+		actions := policyLintStrings(statement["Action"])
+		notActions := policyLintStrings(statement["NotAction"])
+
+		if allow && len(notActions) > 0 {
+			findings = append(findings, PolicyLintFinding{diag.Warning, fmt.Sprintf("%s: NotAction with Effect Allow allows every action not listed, including actions of services added in the future", name)})
+		}
+
+		for _, action := range append(actions, notActions...) {
+			findings = append(findings, policyLintAction(name, action)...)
+		}
+
+		if allow && policyLintContains(policyLintStrings(statement["Resource"]), "*") {
+			for _, action := range actions {
+				for _, dataPlaneAction := range policyLintDataPlaneActions {
+					if policyLintMatch(action, dataPlaneAction) {
+						findings = append(findings, PolicyLintFinding{diag.Warning, fmt.Sprintf("%s: %s allows data plane actions, e.g. %s, on all resources", name, action, dataPlaneAction)})
+						break
+					}
+				}
+			}
+		}
+
+		if v, ok := statement["Condition"]; ok {
+			findings = append(findings, policyLintCondition(name, v)...)
+		}
+	}
+
+	var compact bytes.Buffer
+
+	if err := json.Compact(&compact, []byte(document)); err != nil {
+		return nil, fmt.Errorf("error parsing policy document: %w", err)
+	}
+
+	if n := len([]rune(compact.String())); n > policyMaxManagedPolicySize {
+		findings = append(findings, PolicyLintFinding{diag.Error, fmt.Sprintf("policy document is %d characters without whitespace, which exceeds the managed policy limit of %d", n, policyMaxManagedPolicySize)})
+	}
+
+	return findings, nil
+}
+
+func policyLintAction(name, action string) []PolicyLintFinding {
+	if action == "*" {
+		return nil
+	}
+
+	parts := strings.SplitN(action, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.ContainsAny(parts[0], "*?") {
+		return []PolicyLintFinding{{diag.Error, fmt.Sprintf("%s: malformed action %q, expected service:action", name, action)}}
+	}
+
+	catalog := policyLintCatalog()
+	serviceActions, ok := catalog[strings.ToLower(parts[0])]
+
+	if !ok {
+		return []PolicyLintFinding{{diag.Warning, fmt.Sprintf("%s: unknown service prefix in action %s", name, action)}}
+	}
+
+	pattern := strings.ToLower(parts[1])
+
+	if !strings.ContainsAny(pattern, "*?") {
+		if serviceActions[pattern] {
+			return nil
+		}
+
+		return []PolicyLintFinding{{diag.Warning, fmt.Sprintf("%s: unknown action %s", name, action)}}
+	}
+
+	for serviceAction := range serviceActions {
+		if policyLintMatch(pattern, serviceAction) {
+			return nil
+		}
+	}
+
+	return []PolicyLintFinding{{diag.Warning, fmt.Sprintf("%s: %s matches no known action", name, action)}}
+}
+
+func policyLintCondition(name string, v interface{}) []PolicyLintFinding {
+	condition, ok := v.(map[string]interface{})
+
+	if !ok {
+		return []PolicyLintFinding{{diag.Error, fmt.Sprintf("%s: Condition is not an object", name)}}
+	}
+
+	var findings []PolicyLintFinding
+
+	for _, operator := range policyLintSortedKeys(condition) {
+		v := condition[operator]
+
+		if !policyLintConditionOperator(operator) {
+			findings = append(findings, PolicyLintFinding{diag.Error, fmt.Sprintf("%s: malformed condition operator %s", name, operator)})
+		}
+
+		keys, ok := v.(map[string]interface{})
+
+		if !ok {
+			findings = append(findings, PolicyLintFinding{diag.Error, fmt.Sprintf("%s: condition operator %s is not an object", name, operator)})
+			continue
+		}
+
+		for _, key := range policyLintSortedKeys(keys) {
+			if parts := strings.SplitN(key, ":", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				findings = append(findings, PolicyLintFinding{diag.Error, fmt.Sprintf("%s: malformed condition key %s, expected service:key", name, key)})
+			}
+		}
+	}
+
+	return findings
+}
+
+// policyLintConditionOperator returns whether the operator is valid, optionally qualified with ForAllValues or ForAnyValue and suffixed with IfExists.
+func policyLintConditionOperator(operator string) bool {
+	for _, qualifier := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if strings.HasPrefix(operator, qualifier) {
+			operator = strings.TrimPrefix(operator, qualifier)
+			break
+		}
+	}
+
+	if operator != "NullIfExists" {
+		operator = strings.TrimSuffix(operator, "IfExists")
+	}
+
+	return policyLintConditionOperators[operator]
+}
+
+// policyLintCatalog returns the known actions in lower case by service prefix.
+func policyLintCatalog() map[string]map[string]bool {
+	policyLintActionsOnce.Do(func() {
+		policyLintActions = make(map[string]map[string]bool)
+
+		add := func(prefix string, actions []string) {
+			prefix = strings.ToLower(prefix)
+
+			if policyLintActions[prefix] == nil {
+				policyLintActions[prefix] = make(map[string]bool)
+			}
+
+			for _, action := range actions {
+				policyLintActions[prefix][strings.ToLower(action)] = true
+			}
+		}
+
+		for _, line := range strings.Split(policyLintActionsData, "\n") {
+			if fields := strings.Fields(line); len(fields) > 0 && !strings.HasPrefix(fields[0], "#") {
+				add(fields[0], fields[1:])
+			}
+		}
+
+		for prefix, actions := range policyLintSupplementalActions {
+			add(prefix, actions)
+		}
+	})
+
+	return policyLintActions
+}
+
+// policyLintMatch returns whether s matches the case-insensitive IAM wildcard pattern, in which * matches any sequence of characters and ? any single character.
+func policyLintMatch(pattern, s string) bool {
+	pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(s); i >= 0; i-- {
+				if policyLintMatch(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+		default:
+			if len(s) == 0 || pattern[0] != s[0] {
+				return false
+			}
+		}
+
+		pattern, s = pattern[1:], s[1:]
+	}
+
+	return len(s) == 0
+}
+
+func policyLintStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var output []string
+
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				output = append(output, v)
+			}
+		}
+
+		return output
+	default:
+		return nil
+	}
+}
+
+func policyLintSortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func policyLintContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyLintDiagnostics returns the findings as diagnostics.
+// In warn mode every finding is a warning, in error mode findings keep their severity.
+func policyLintDiagnostics(findings []PolicyLintFinding, mode string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, finding := range findings {
+		severity := finding.Severity
+
+		if mode == PolicyLintModeWarn {
+			severity = diag.Warning
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  "IAM policy document lint",
+			Detail:   finding.Summary,
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,146 @@
+package iam
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestLintPolicyDocument(t *testing.T) {
+	testCases := []struct {
+		name     string
+		document string
+		want     []PolicyLintFinding
+	}{
+		{
+			name: "valid",
+			document: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:ListBucket", "s3:GetObject*", "iam:PassRole", "ec2:Describe*"],
+      "Resource": "arn:aws:s3:::example",
+      "Condition": {
+        "ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": "a*"},
+        "Bool": {"aws:SecureTransport": "true"}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "iam:*",
+      "Resource": "*"
+    }
+  ]
+}`,
+		},
+		{
+			name: "actions",
+			document: `{
+  "Statement": {
+    "Effect": "Deny",
+    "Action": ["s3:GetObjekt", "s4:GetObject", "ec2:Descr1be*", "GetObject"],
+    "Resource": "*"
+  }
+}`,
+			want: []PolicyLintFinding{
+				{diag.Warning, "statement 0: unknown action s3:GetObjekt"},
+				{diag.Warning, "statement 0: unknown service prefix in action s4:GetObject"},
+				{diag.Warning, "statement 0: ec2:Descr1be* matches no known action"},
+				{diag.Error, `statement 0: malformed action "GetObject", expected service:action`},
+			},
+		},
+		{
+			name: "not action allow",
+			document: `{
+  "Statement": [{"Sid": "A", "Effect": "Allow", "NotAction": "iam:*", "NotResource": "*"}]
+}`,
+			want: []PolicyLintFinding{
+				{diag.Warning, "statement 0 (A): NotAction with Effect Allow allows every action not listed, including actions of services added in the future"},
+			},
+		},
+		{
+			name: "data plane on all resources",
+			document: `{
+  "Statement": [{"Effect": "Allow", "Action": ["s3:Get*", "s3:ListBucket"], "Resource": "*"}]
+}`,
+			want: []PolicyLintFinding{
+				{diag.Warning, "statement 0: s3:Get* allows data plane actions, e.g. s3:GetObject, on all resources"},
+			},
+		},
+		{
+			name: "conditions",
+			document: `{
+  "Statement": [{
+    "Effect": "Deny",
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::example/*",
+    "Condition": {"StringEqual": {"s3:x-amz-acl": "public-read"}, "NullIfExists": {"SecureTransport": "true"}}
+  }]
+}`,
+			want: []PolicyLintFinding{
+				{diag.Error, "statement 0: malformed condition operator NullIfExists"},
+				{diag.Error, "statement 0: malformed condition key SecureTransport, expected service:key"},
+				{diag.Error, "statement 0: malformed condition operator StringEqual"},
+			},
+		},
+		{
+			name: "duplicate sids",
+			document: `{
+  "Statement": [
+    {"Sid": "A", "Effect": "Deny", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::a/*"},
+    {"Sid": "A", "Effect": "Deny", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::b/*"}
+  ]
+}`,
+			want: []PolicyLintFinding{
+				{diag.Error, "statement 1 (A): duplicate Sid"},
+			},
+		},
+		{
+			name:     "size",
+			document: fmt.Sprintf(`{"Statement": [{"Effect": "Deny", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::%s/*"}]}`, strings.Repeat("a", 6100)),
+			want: []PolicyLintFinding{
+				{diag.Error, "policy document is 6186 characters without whitespace, which exceeds the managed policy limit of 6144"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := LintPolicyDocument(testCase.document)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPolicyLintMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"s3:*", "s3:GetObject", true},
+		{"S3:get*", "s3:GetObject", true},
+		{"s3:Get?bject", "s3:GetObject", true},
+		{"s3:*Object", "s3:GetObjectAcl", false},
+		{"s3:Get*Acl", "s3:GetObjectAcl", true},
+		{"*", "s3:GetObject", true},
+		{"s3:Put*", "s3:GetObject", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := policyLintMatch(testCase.pattern, testCase.s); got != testCase.want {
+			t.Errorf("policyLintMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, testCase.want)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package iam
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// policyStatement is a minified statement of a policy document.
+type policyStatement struct {
+	id   string
+	json string
+}
+
+// policyDocumentParts is a minified policy document split into its header and statements.
+type policyDocumentParts struct {
+	id         string
+	statements []*policyStatement
+	version    string
+}
+
+// splitPolicyDocument minifies the policy document and splits it into statements.
+// Each statement is identified by its Sid or, if it has none, by a hash of its contents.
+func splitPolicyDocument(document string) (*policyDocumentParts, error) {
+	var doc struct {
+		Id        string
+		Statement json.RawMessage
+		Version   string
+	}
+
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, fmt.Errorf("error parsing policy document: %w", err)
+	}
+
+	var rawStatements []json.RawMessage
+
+	if trimmed := bytes.TrimSpace(doc.Statement); len(trimmed) > 0 && trimmed[0] == '{' {
+		rawStatements = []json.RawMessage{doc.Statement}
+	} else if len(trimmed) > 0 {
+		if err := json.Unmarshal(doc.Statement, &rawStatements); err != nil {
+			return nil, fmt.Errorf("error parsing policy document statements: %w", err)
+		}
+	}
+
+	parts := &policyDocumentParts{
+		id:      doc.Id,
+		version: doc.Version,
+	}
+	ids := make(map[string]int)
+
+	for _, rawStatement := range rawStatements {
+		var buf bytes.Buffer
+
+		if err := json.Compact(&buf, rawStatement); err != nil {
+			return nil, fmt.Errorf("error parsing policy document statement: %w", err)
+		}
+
+		var statement struct {
+			Sid string
+		}
+
+		if err := json.Unmarshal(buf.Bytes(), &statement); err != nil {
+			return nil, fmt.Errorf("error parsing policy document statement: %w", err)
+		}
+
+		id := statement.Sid
+
+		if id == "" {
+			hash := sha256.Sum256(buf.Bytes())
+			id = "sha256:" + hex.EncodeToString(hash[:8])
+		} else if _, ok := ids[id]; ok {
+			return nil, fmt.Errorf("duplicate Sid (%s) in policy document", id)
+		}
+
+		// Identical statements without a Sid get distinct IDs.
+		if n := ids[id]; n > 0 {
+			ids[id] = n + 1
+			id = fmt.Sprintf("%s#%d", id, n+1)
+		} else {
+			ids[id] = 1
+		}
+
+		parts.statements = append(parts.statements, &policyStatement{
+			id:   id,
+			json: buf.String(),
+		})
+	}
+
+	return parts, nil
+}
+
+// document returns the minified policy document with the specified statements.
+func (p *policyDocumentParts) document(statements []*policyStatement) string {
+	var sb strings.Builder
+
+	sb.WriteString("{")
+
+	if p.version != "" {
+		v, _ := json.Marshal(p.version)
+		sb.WriteString(`"Version":`)
+		sb.Write(v)
+		sb.WriteString(",")
+	}
+
+	if p.id != "" {
+		v, _ := json.Marshal(p.id)
+		sb.WriteString(`"Id":`)
+		sb.Write(v)
+		sb.WriteString(",")
+	}
+
+	sb.WriteString(`"Statement":[`)
+
+	for i, statement := range statements {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+
+		sb.WriteString(statement.json)
+	}
+
+	sb.WriteString("]}")
+
+	return sb.String()
+}
+
+// size returns the number of characters in the minified policy document with the specified statements.
+func (p *policyDocumentParts) size(statements []*policyStatement) int {
+	size := len([]rune(p.document(nil)))
+
+	for i, statement := range statements {
+		if i > 0 {
+			size++
+		}
+
+		size += len([]rune(statement.json))
+	}
+
+	return size
+}
+
+// packPolicyStatements assigns the statements of a policy document to policies of at most maxSize characters.
+// previous is the statement IDs of each existing policy. The result has an element for each existing policy,
+// which is empty if the policy is no longer needed, followed by any new policies.
+//
+// To keep changes small, statements stay in their existing policy while it has room for them and
+// only new statements, and statements that no longer fit, are moved. They are placed first-fit in
+// decreasing order of size. Finally, policies are emptied into the others while possible so that
+// no more policies are used than needed.
+func packPolicyStatements(parts *policyDocumentParts, previous [][]string, maxSize int) ([][]*policyStatement, error) {
+	byID := make(map[string]*policyStatement)
+	order := make(map[string]int)
+
+	for i, statement := range parts.statements {
+		if parts.size([]*policyStatement{statement}) > maxSize {
+			return nil, fmt.Errorf("policy statement (%s) is too large for a managed policy: %d characters, the maximum is %d", statement.id, parts.size([]*policyStatement{statement}), maxSize)
+		}
+
+		byID[statement.id] = statement
+		order[statement.id] = i
+	}
+
+	assigned := make(map[string]bool)
+	bins := make([][]*policyStatement, len(previous))
+
+	for i, ids := range previous {
+		for _, id := range ids {
+			if statement, ok := byID[id]; ok && !assigned[id] {
+				bins[i] = append(bins[i], statement)
+				assigned[id] = true
+			}
+		}
+
+		// Statements may have grown.
+		for len(bins[i]) > 0 && parts.size(bins[i]) > maxSize {
+			last := bins[i][len(bins[i])-1]
+			bins[i] = bins[i][:len(bins[i])-1]
+			assigned[last.id] = false
+		}
+	}
+
+	var pending []*policyStatement
+
+	for _, statement := range parts.statements {
+		if !assigned[statement.id] {
+			pending = append(pending, statement)
+		}
+	}
+
+	bins = packPolicyStatementsFirstFit(parts, bins, pending, maxSize, false)
+
+	// Empty the smallest policy into the others while possible.
+	for {
+		smallest := -1
+
+		for i, bin := range bins {
+			if len(bin) > 0 && (smallest == -1 || parts.size(bin) < parts.size(bins[smallest])) {
+				smallest = i
+			}
+		}
+
+		if smallest == -1 {
+			break
+		}
+
+		candidate := make([][]*policyStatement, len(bins))
+
+		for i, bin := range bins {
+			if i != smallest {
+				candidate[i] = append([]*policyStatement(nil), bin...)
+			}
+		}
+
+		candidate = packPolicyStatementsFirstFit(parts, candidate, bins[smallest], maxSize, true)
+
+		if len(candidate) > len(bins) {
+			break
+		}
+
+		bins = candidate
+	}
+
+	var output [][]*policyStatement
+
+	for i, bin := range bins {
+		// New policies that were emptied aren't needed.
+		if i >= len(previous) && len(bin) == 0 {
+			continue
+		}
+
+		sort.SliceStable(bin, func(a, b int) bool {
+			return order[bin[a].id] < order[bin[b].id]
+		})
+
+		output = append(output, bin)
+	}
+
+	return output, nil
+}
+
+// packPolicyStatementsFirstFit adds the statements, largest first, to the first policy with room for them.
+// If nonEmptyOnly is set, empty policies are skipped. New policies are appended as needed.
+func packPolicyStatementsFirstFit(parts *policyDocumentParts, bins [][]*policyStatement, statements []*policyStatement, maxSize int, nonEmptyOnly bool) [][]*policyStatement {
+	statements = append([]*policyStatement(nil), statements...)
+
+	sort.SliceStable(statements, func(i, j int) bool {
+		return len(statements[i].json) > len(statements[j].json)
+	})
+
+	for _, statement := range statements {
+		placed := false
+
+		for i, bin := range bins {
+			if nonEmptyOnly && len(bin) == 0 {
+				continue
+			}
+
+			if parts.size(append(append([]*policyStatement(nil), bin...), statement)) <= maxSize {
+				bins[i] = append(bin, statement)
+				placed = true
+				break
+			}
+		}
+
+		if !placed {
+			bins = append(bins, []*policyStatement{statement})
+		}
+	}
+
+	return bins
+}
@@ -0,0 +1,172 @@
+package iam
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testPolicyPackingDocument(sids ...string) string {
+	var statements []string
+
+	for _, sid := range sids {
+		statements = append(statements, fmt.Sprintf(`{
+      "Sid": %q,
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::%s/*"
+    }`, sid, strings.Repeat("a", 100)))
+	}
+
+	return fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    %s
+  ]
+}`, strings.Join(statements, ",\n    "))
+}
+
+func testPolicyPackingIDs(bins [][]*policyStatement) [][]string {
+	var ids [][]string
+
+	for _, bin := range bins {
+		binIDs := []string{}
+
+		for _, statement := range bin {
+			binIDs = append(binIDs, statement.id)
+		}
+
+		ids = append(ids, binIDs)
+	}
+
+	return ids
+}
+
+func TestSplitPolicyDocument(t *testing.T) {
+	parts, err := splitPolicyDocument(`{
+  "Version": "2012-10-17",
+  "Id": "example",
+  "Statement": [
+    {"Sid": "A", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}
+  ]
+}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(parts.statements), 3; got != want {
+		t.Fatalf("got %d statements, want %d", got, want)
+	}
+
+	if got, want := parts.statements[0].id, "A"; got != want {
+		t.Errorf("got ID %s, want %s", got, want)
+	}
+
+	if got, want := parts.statements[2].id, parts.statements[1].id+"#2"; got != want {
+		t.Errorf("got ID %s, want %s", got, want)
+	}
+
+	want := `{"Version":"2012-10-17","Id":"example","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+
+	if got := parts.document(parts.statements[:1]); got != want {
+		t.Errorf("got document %s, want %s", got, want)
+	}
+
+	if got, want := parts.size(parts.statements[:1]), len(want); got != want {
+		t.Errorf("got size %d, want %d", got, want)
+	}
+
+	if _, err := splitPolicyDocument(`{"Statement": [{"Sid": "A"}, {"Sid": "A"}]}`); err == nil {
+		t.Error("expected duplicate Sid error, got none")
+	}
+}
+
+func TestPackPolicyStatements(t *testing.T) {
+	parts, err := splitPolicyDocument(testPolicyPackingDocument("A", "B", "C", "D", "E"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Room for 2 statements per policy.
+	maxSize := parts.size(parts.statements[:2])
+
+	testCases := []struct {
+		name     string
+		sids     []string
+		previous [][]string
+		want     [][]string
+	}{
+		{
+			name: "new",
+			sids: []string{"A", "B", "C", "D", "E"},
+			want: [][]string{{"A", "B"}, {"C", "D"}, {"E"}},
+		},
+		{
+			name:     "unchanged",
+			sids:     []string{"A", "B", "C", "D", "E"},
+			previous: [][]string{{"A", "B"}, {"C", "D"}, {"E"}},
+			want:     [][]string{{"A", "B"}, {"C", "D"}, {"E"}},
+		},
+		{
+			name:     "added",
+			sids:     []string{"A", "B", "C", "D", "E", "F"},
+			previous: [][]string{{"A", "B"}, {"C", "D"}, {"E"}},
+			want:     [][]string{{"A", "B"}, {"C", "D"}, {"E", "F"}},
+		},
+		{
+			name:     "removed",
+			sids:     []string{"A", "C", "D", "E"},
+			previous: [][]string{{"A", "B"}, {"C", "D"}, {"E"}},
+			want:     [][]string{{}, {"C", "D"}, {"A", "E"}},
+		},
+		{
+			name:     "all removed from policy",
+			sids:     []string{"A", "B", "E"},
+			previous: [][]string{{"A", "B"}, {"C", "D"}, {"E"}},
+			want:     [][]string{{"A", "B"}, {}, {"E"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			parts, err := splitPolicyDocument(testPolicyPackingDocument(testCase.sids...))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			bins, err := packPolicyStatements(parts, testCase.previous, maxSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := testPolicyPackingIDs(bins); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+
+			for _, bin := range bins {
+				if size := parts.size(bin); size > maxSize {
+					t.Errorf("policy size %d exceeds %d", size, maxSize)
+				}
+			}
+		})
+	}
+}
+
+func TestPackPolicyStatementsTooLarge(t *testing.T) {
+	parts, err := splitPolicyDocument(testPolicyPackingDocument("A"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := packPolicyStatements(parts, nil, 100); err == nil {
+		t.Error("expected error, got none")
+	}
+}
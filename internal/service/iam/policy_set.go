@@ -0,0 +1,568 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Room for a "-n" suffix.
+	policySetNameMaxLen = policyNameMaxLen - 4
+)
+
+func ResourcePolicySet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicySetCreate,
+		ReadWithoutTimeout:   resourcePolicySetRead,
+		UpdateWithoutTimeout: resourcePolicySetUpdate,
+		DeleteWithoutTimeout: resourcePolicySetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName(policySetNameMaxLen),
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+				ForceNew: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"document": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+			},
+			"role": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user"},
+			},
+			"user": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"role"},
+			},
+		},
+
+		CustomizeDiff: customdiff.ComputedIf("policies", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("policy")
+		}),
+	}
+}
+
+func resourcePolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	name := d.Get("name").(string)
+	d.SetId(name)
+
+	if err := policySetApply(ctx, conn, d); err != nil {
+		return diag.Errorf("creating IAM Policy Set (%s): %s", name, err)
+	}
+
+	return resourcePolicySetRead(ctx, d, meta)
+}
+
+func resourcePolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	attached, err := findPolicySetAttachedPolicyARNs(ctx, conn, d.Get("role").(string), d.Get("user").(string))
+
+	if err != nil {
+		return diag.Errorf("reading IAM Policy Set (%s) attachments: %s", d.Id(), err)
+	}
+
+	var policies []*policySetPolicy
+	combined := &policyDocumentParts{}
+
+	for _, policy := range expandPolicySetPolicies(d.Get("policies").([]interface{})) {
+		outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+			return findPolicySetPolicy(ctx, conn, policy.arn)
+		}, d.IsNewResource())
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] IAM Policy Set (%s) policy %s not found, removing from state", d.Id(), policy.arn)
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("reading IAM Policy Set (%s) policy %s: %s", d.Id(), policy.arn, err)
+		}
+
+		policy = outputRaw.(*policySetPolicy)
+		policies = append(policies, policy)
+
+		// Statements of detached policies aren't in effect.
+		if attached != nil && !attached[policy.arn] {
+			continue
+		}
+
+		parts, err := splitPolicyDocument(policy.document)
+
+		if err != nil {
+			return diag.Errorf("reading IAM Policy Set (%s) policy %s: %s", d.Id(), policy.arn, err)
+		}
+
+		combined.id = parts.id
+		combined.statements = append(combined.statements, parts.statements...)
+		combined.version = parts.version
+	}
+
+	if !d.IsNewResource() && len(policies) == 0 {
+		log.Printf("[WARN] IAM Policy Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("policies", flattenPolicySetPolicies(policies)); err != nil {
+		return diag.Errorf("setting policies: %s", err)
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), combined.document(combined.statements))
+
+	if err != nil {
+		return diag.Errorf("while setting policy (%s), encountered: %s", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policyToSet, err)
+	}
+
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourcePolicySetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if err := policySetApply(ctx, conn, d); err != nil {
+		return diag.Errorf("updating IAM Policy Set (%s): %s", d.Id(), err)
+	}
+
+	return resourcePolicySetRead(ctx, d, meta)
+}
+
+func resourcePolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	for _, policy := range expandPolicySetPolicies(d.Get("policies").([]interface{})) {
+		log.Printf("[DEBUG] Deleting IAM Policy Set (%s) policy: %s", d.Id(), policy.arn)
+		if err := policySetDeletePolicy(conn, d.Get("role").(string), d.Get("user").(string), policy.arn); err != nil {
+			return diag.Errorf("deleting IAM Policy Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// policySetPolicy is a managed policy of a policy set.
+type policySetPolicy struct {
+	arn          string
+	document     string
+	name         string
+	statementIDs []string
+}
+
+// policySetApply packs the statements of the configured policy document into managed policies,
+// then creates, updates, attaches and deletes managed policies to match.
+func policySetApply(ctx context.Context, conn *iam.IAM, d *schema.ResourceData) error {
+	role := d.Get("role").(string)
+	user := d.Get("user").(string)
+
+	parts, err := splitPolicyDocument(d.Get("policy").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if len(parts.statements) == 0 {
+		return fmt.Errorf("policy document has no statements")
+	}
+
+	o, _ := d.GetChange("policies")
+	policies := expandPolicySetPolicies(o.([]interface{}))
+	previous := make([][]string, len(policies))
+	names := make(map[string]bool)
+
+	for i, policy := range policies {
+		previous[i] = policy.statementIDs
+		names[policy.name] = true
+	}
+
+	bins, err := packPolicyStatements(parts, previous, policyMaxManagedPolicySize)
+
+	if err != nil {
+		return err
+	}
+
+	// Statements moved between policies must stay in effect throughout, so new policies are created
+	// and attached, and existing policies are attached, before any policy is updated. Policies that
+	// gain statements are updated before those that lose them, and emptied policies are deleted last.
+	for i := len(policies); i < len(bins); i++ {
+		var name string
+		for n := 1; name == "" || names[name]; n++ {
+			name = fmt.Sprintf("%s-%d", d.Id(), n)
+		}
+		names[name] = true
+
+		document := parts.document(bins[i])
+		input := &iam.CreatePolicyInput{
+			Path:           aws.String(d.Get("path").(string)),
+			PolicyDocument: aws.String(document),
+			PolicyName:     aws.String(name),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating IAM Policy: %s", input)
+		output, err := conn.CreatePolicyWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("creating IAM Policy (%s): %w", name, err)
+		}
+
+		policies = append(policies, &policySetPolicy{
+			arn:          aws.StringValue(output.Policy.Arn),
+			document:     document,
+			name:         name,
+			statementIDs: policySetStatementIDs(bins[i]),
+		})
+
+		// Keep track of the policy even if a later step fails.
+		if err := d.Set("policies", flattenPolicySetPolicies(policies)); err != nil {
+			return fmt.Errorf("setting policies: %w", err)
+		}
+
+		if err := policySetAttachPolicy(conn, role, user, aws.StringValue(output.Policy.Arn)); err != nil {
+			return err
+		}
+	}
+
+	// Attaching is idempotent, which also restores detached policies.
+	for i := range previous {
+		if len(bins[i]) == 0 {
+			continue
+		}
+
+		if err := policySetAttachPolicy(conn, role, user, policies[i].arn); err != nil {
+			return err
+		}
+	}
+
+	var updates []int
+
+	for i := range previous {
+		if len(bins[i]) > 0 && parts.document(bins[i]) != policies[i].document {
+			updates = append(updates, i)
+		}
+	}
+
+	sort.SliceStable(updates, func(i, j int) bool {
+		return policySetGainsStatements(previous[updates[i]], bins[updates[i]]) && !policySetGainsStatements(previous[updates[j]], bins[updates[j]])
+	})
+
+	for _, i := range updates {
+		policy := policies[i]
+
+		if err := policyPruneVersions(policy.arn, conn); err != nil {
+			return err
+		}
+
+		document := parts.document(bins[i])
+		input := &iam.CreatePolicyVersionInput{
+			PolicyArn:      aws.String(policy.arn),
+			PolicyDocument: aws.String(document),
+			SetAsDefault:   aws.Bool(true),
+		}
+
+		log.Printf("[DEBUG] Creating IAM Policy Version: %s", input)
+		if _, err := conn.CreatePolicyVersionWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating IAM Policy (%s): %w", policy.arn, err)
+		}
+
+		policy.document = document
+		policy.statementIDs = policySetStatementIDs(bins[i])
+	}
+
+	var remaining []*policySetPolicy
+
+	for i, policy := range policies {
+		if len(bins[i]) > 0 {
+			remaining = append(remaining, policy)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting IAM Policy: %s", policy.arn)
+		if err := policySetDeletePolicy(conn, role, user, policy.arn); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("policies", flattenPolicySetPolicies(remaining)); err != nil {
+		return fmt.Errorf("setting policies: %w", err)
+	}
+
+	return nil
+}
+
+func policySetAttachPolicy(conn *iam.IAM, role, user, arn string) error {
+	if role == "" && user == "" {
+		return nil
+	}
+
+	// Retry for IAM eventual consistency.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		if role != "" {
+			return nil, attachPolicyToRole(conn, role, arn)
+		}
+
+		return nil, attachPolicyToUser(conn, user, arn)
+	}, iam.ErrCodeNoSuchEntityException)
+
+	if err != nil {
+		return fmt.Errorf("attaching IAM Policy (%s): %w", arn, err)
+	}
+
+	return nil
+}
+
+func policySetDeletePolicy(conn *iam.IAM, role, user, arn string) error {
+	var err error
+
+	if role != "" {
+		err = DetachPolicyFromRole(conn, role, arn)
+	} else if user != "" {
+		err = DetachPolicyFromUser(conn, user, arn)
+	}
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return fmt.Errorf("detaching IAM Policy (%s): %w", arn, err)
+	}
+
+	if err := PolicyDeleteNondefaultVersions(arn, conn); err != nil {
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			return nil
+		}
+
+		return err
+	}
+
+	_, err = conn.DeletePolicy(&iam.DeletePolicyInput{
+		PolicyArn: aws.String(arn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting IAM Policy (%s): %w", arn, err)
+	}
+
+	return nil
+}
+
+// findPolicySetPolicy returns the managed policy with its default version document.
+func findPolicySetPolicy(ctx context.Context, conn *iam.IAM, arn string) (*policySetPolicy, error) {
+	policy, err := FindPolicyByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := FindPolicyVersion(ctx, conn, arn, aws.StringValue(policy.DefaultVersionId))
+
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := url.QueryUnescape(aws.StringValue(version.Document))
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing policy document: %w", err)
+	}
+
+	parts, err := splitPolicyDocument(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &policySetPolicy{
+		arn:          arn,
+		document:     document,
+		name:         aws.StringValue(policy.PolicyName),
+		statementIDs: policySetStatementIDs(parts.statements),
+	}, nil
+}
+
+// findPolicySetAttachedPolicyARNs returns the ARNs of the managed policies attached to the role or user.
+// The result is nil if neither is set.
+func findPolicySetAttachedPolicyARNs(ctx context.Context, conn *iam.IAM, role, user string) (map[string]bool, error) {
+	if role == "" && user == "" {
+		return nil, nil
+	}
+
+	arns := make(map[string]bool)
+	add := func(policies []*iam.AttachedPolicy) {
+		for _, policy := range policies {
+			if policy != nil {
+				arns[aws.StringValue(policy.PolicyArn)] = true
+			}
+		}
+	}
+
+	var err error
+
+	if role != "" {
+		err = conn.ListAttachedRolePoliciesPagesWithContext(ctx, &iam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(role),
+		}, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+			if page != nil {
+				add(page.AttachedPolicies)
+			}
+
+			return !lastPage
+		})
+	} else {
+		err = conn.ListAttachedUserPoliciesPagesWithContext(ctx, &iam.ListAttachedUserPoliciesInput{
+			UserName: aws.String(user),
+		}, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+			if page != nil {
+				add(page.AttachedPolicies)
+			}
+
+			return !lastPage
+		})
+	}
+
+	// Nothing is attached to a role or user that no longer exists.
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return arns, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return arns, nil
+}
+
+// policySetGainsStatements returns whether the policy has statements that it didn't have before.
+func policySetGainsStatements(previous []string, statements []*policyStatement) bool {
+	ids := make(map[string]bool)
+
+	for _, id := range previous {
+		ids[id] = true
+	}
+
+	for _, statement := range statements {
+		if !ids[statement.id] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policySetStatementIDs(statements []*policyStatement) []string {
+	ids := make([]string, 0, len(statements))
+
+	for _, statement := range statements {
+		ids = append(ids, statement.id)
+	}
+
+	return ids
+}
+
+func expandPolicySetPolicies(tfList []interface{}) []*policySetPolicy {
+	var policies []*policySetPolicy
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		policies = append(policies, &policySetPolicy{
+			arn:          tfMap["arn"].(string),
+			document:     tfMap["document"].(string),
+			name:         tfMap["name"].(string),
+			statementIDs: aws.StringValueSlice(flex.ExpandStringList(tfMap["statement_ids"].([]interface{}))),
+		})
+	}
+
+	return policies
+}
+
+func flattenPolicySetPolicies(policies []*policySetPolicy) []interface{} {
+	tfList := make([]interface{}, 0, len(policies))
+
+	for _, policy := range policies {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":           policy.arn,
+			"document":      policy.document,
+			"name":          policy.name,
+			"statement_ids": policy.statementIDs,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,125 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// testPolicySetConn returns an IAM client that doesn't send requests. Each call is recorded with
+// the name of the policy it acts on, and CreatePolicy returns an ARN for the new policy.
+func testPolicySetConn(t *testing.T, calls *[]string) *iam.IAM {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn := iam.New(sess)
+	conn.Handlers.Send.Clear()
+	conn.Handlers.UnmarshalMeta.Clear()
+	conn.Handlers.Unmarshal.Clear()
+	conn.Handlers.UnmarshalError.Clear()
+	conn.Handlers.ValidateResponse.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		var name string
+
+		switch input := r.Params.(type) {
+		case *iam.AttachRolePolicyInput:
+			name = aws.StringValue(input.PolicyArn)
+		case *iam.CreatePolicyInput:
+			name = aws.StringValue(input.PolicyName)
+			r.Data.(*iam.CreatePolicyOutput).Policy = &iam.Policy{
+				Arn: aws.String("arn:aws:iam::123456789012:policy/" + name), //lintignore:AWSAT005
+			}
+		case *iam.CreatePolicyVersionInput:
+			name = aws.StringValue(input.PolicyArn)
+		case *iam.ListPolicyVersionsInput:
+			name = aws.StringValue(input.PolicyArn)
+		}
+
+		*calls = append(*calls, fmt.Sprintf("%s %s", r.Operation.Name, name[strings.LastIndex(name, "/")+1:]))
+	})
+
+	return conn
+}
+
+func testPolicySetDocument(statements map[string]int) string {
+	var parts []string
+
+	for _, sid := range []string{"A", "B"} {
+		if n, ok := statements[sid]; ok {
+			parts = append(parts, fmt.Sprintf(`{"Sid":%q,"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::%s/*"}`, sid, strings.Repeat("a", n))) //lintignore:AWSAT005
+		}
+	}
+
+	return fmt.Sprintf(`{"Version":"2012-10-17","Statement":[%s]}`, strings.Join(parts, ","))
+}
+
+// TestPolicySetApplyOrder verifies that a statement moved out of an existing policy into a new policy
+// is attached to the role before the existing policy is updated without it.
+func TestPolicySetApplyOrder(t *testing.T) {
+	oldDocument := testPolicySetDocument(map[string]int{"A": 100, "B": 3000})
+	parts, err := splitPolicyDocument(oldDocument)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := ResourcePolicySet()
+	d := r.TestResourceData()
+	d.SetId("test")
+
+	for k, v := range map[string]interface{}{
+		"name":   "test",
+		"path":   "/",
+		"policy": oldDocument,
+		"role":   "role",
+		"policies": flattenPolicySetPolicies([]*policySetPolicy{{
+			arn:          "arn:aws:iam::123456789012:policy/test-1", //lintignore:AWSAT005
+			document:     parts.document(parts.statements),
+			name:         "test-1",
+			statementIDs: []string{"A", "B"},
+		}}),
+	} {
+		if err := d.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d = r.Data(d.State())
+
+	// A grows so that B no longer fits in the existing policy.
+	if err := d.Set("policy", testPolicySetDocument(map[string]int{"A": 4000, "B": 3000})); err != nil {
+		t.Fatal(err)
+	}
+
+	var calls []string
+
+	if err := policySetApply(context.Background(), testPolicySetConn(t, &calls), d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"CreatePolicy test-2",
+		"AttachRolePolicy test-2",
+		"AttachRolePolicy test-1",
+		"ListPolicyVersions test-1",
+		"CreatePolicyVersion test-1",
+	}
+
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("got calls:\n%s\n\nexpected:\n%s", strings.Join(calls, "\n"), strings.Join(expected, "\n"))
+	}
+}
@@ -0,0 +1,221 @@
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIAMPolicySet_basic(t *testing.T) {
+	var arns []string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySetConfig(rName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicySetExists(resourceName, &arns),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policies.0.name", rName+"-1"),
+					resource.TestCheckResourceAttr(resourceName, "policies.1.name", rName+"-2"),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "name"),
+				),
+			},
+			{
+				// Adding a statement doesn't move the existing ones.
+				Config: testAccPolicySetConfig(rName, 9),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicySetNotRepacked(resourceName, &arns),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "2"),
+				),
+			},
+			{
+				Config: testAccPolicySetConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicySetExists(resourceName, &arns),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicySet_disappears(t *testing.T) {
+	var arns []string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iam.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySetConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicySetExists(resourceName, &arns),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiam.ResourcePolicySet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicySetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_policy_set" {
+			continue
+		}
+
+		for _, arn := range testAccPolicySetARNs(rs) {
+			_, err := tfiam.FindPolicyByARN(context.TODO(), conn, arn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IAM Policy Set %s policy %s still exists", rs.Primary.ID, arn)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPolicySetExists(n string, v *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IAM Policy Set ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		arns := testAccPolicySetARNs(rs)
+
+		for _, arn := range arns {
+			if _, err := tfiam.FindPolicyByARN(context.TODO(), conn, arn); err != nil {
+				return err
+			}
+
+			attached, err := tfiam.RoleHasPolicyARNAttachment(conn, rs.Primary.Attributes["role"], arn)
+
+			if err != nil {
+				return err
+			}
+
+			if !attached {
+				return fmt.Errorf("IAM Policy Set %s policy %s is not attached", rs.Primary.ID, arn)
+			}
+		}
+
+		*v = arns
+
+		return nil
+	}
+}
+
+// testAccCheckPolicySetNotRepacked checks that the policies are the same as before and
+// that the statements of the first policy didn't change.
+func testAccCheckPolicySetNotRepacked(n string, v *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		arns := testAccPolicySetARNs(rs)
+
+		if len(arns) < len(*v) {
+			return fmt.Errorf("IAM Policy Set %s has %d policies, expected at least %d", rs.Primary.ID, len(arns), len(*v))
+		}
+
+		for i, arn := range *v {
+			if arns[i] != arn {
+				return fmt.Errorf("IAM Policy Set %s policy %d is %s, expected %s", rs.Primary.ID, i, arns[i], arn)
+			}
+		}
+
+		return resource.TestCheckResourceAttr(n, "policies.0.statement_ids.#", "6")(s)
+	}
+}
+
+func testAccPolicySetARNs(rs *terraform.ResourceState) []string {
+	var arns []string
+
+	n, _ := strconv.Atoi(rs.Primary.Attributes["policies.#"])
+
+	for i := 0; i < n; i++ {
+		arns = append(arns, rs.Primary.Attributes[fmt.Sprintf("policies.%d.arn", i)])
+	}
+
+	return arns
+}
+
+func testAccPolicySetConfig(rName string, statements int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+# Each statement is about 950 characters, so 6 fit in a managed policy.
+data "aws_iam_policy_document" "test" {
+  dynamic "statement" {
+    for_each = range(%[2]d)
+
+    content {
+      sid       = "Statement${statement.value}"
+      actions   = ["s3:GetObject", "s3:PutObject"]
+      resources = [for i in range(20) : "arn:${data.aws_partition.current.partition}:s3:::${substr(%[1]q, 0, 20)}-${statement.value}-${i}/*"]
+    }
+  }
+}
+
+resource "aws_iam_policy_set" "test" {
+  name   = %[1]q
+  policy = data.aws_iam_policy_document.test.json
+  role   = aws_iam_role.test.name
+}
+`, rName, statements)
+}
@@ -126,6 +126,7 @@ func CheckPwdPolicy(pass []byte) bool {
 		bytes.ContainsAny(pass, charUpper))
 }
 
+// lintignore:AWSR004
 func resourceUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 	username := d.Get("user").(string)
@@ -57,6 +57,7 @@ func ResourceUserPolicy() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceUserPolicyPut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
@@ -67,6 +67,7 @@ func resourceUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{})
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
 		var err error
 
+		//lintignore:AWSR003
 		attachedPolicy, err = FindUserAttachedPolicy(conn, user, arn)
 
 		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
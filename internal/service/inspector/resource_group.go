@@ -17,6 +17,7 @@ func ResourceResourceGroup() *schema.Resource {
 		Delete: resourceResourceGroupDelete,
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSR006
 			"tags": {
 				ForceNew: true,
 				Required: true,
@@ -71,6 +71,7 @@ func resourceScramSecretAssociationCreate(d *schema.ResourceData, meta interface
 func resourceScramSecretAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).KafkaConn
 
+	//lintignore:AWSR003
 	secretArnList, err := FindScramSecrets(conn, d.Id())
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
@@ -0,0 +1,810 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// PermissionsGrant is the set of permissions that one principal holds on one Lake Formation
+// resource. The authoritative aws_lakeformation_principal_permissions and
+// aws_lakeformation_resource_permissions resources compare the grants they are configured with to
+// the grants AWS lists, so the different shapes AWS uses for the same grant are normalized:
+//  1. SELECT on a table is listed on a table with columns resource with a column wildcard. A table
+//     with columns resource with a column wildcard and no excluded columns is treated as a table.
+//  2. A table wildcard is listed with the table name ALL_TABLES.
+//  3. One grant can be listed as several permissions (e.g., SELECT separately), which are merged.
+//  4. Permissions with grant option are also listed as permissions.
+type PermissionsGrant struct {
+	Key                        string
+	Principal                  string
+	Resource                   *lakeformation.Resource
+	Permissions                []string
+	PermissionsWithGrantOption []string
+
+	resourceKey string
+	tfMap       map[string]interface{}
+}
+
+func permissionsResourceSchema(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"catalog_resource": {
+			Type:     schema.TypeBool,
+			Default:  false,
+			ForceNew: forceNew,
+			Optional: true,
+		},
+		"data_location": {
+			Type:     schema.TypeList,
+			ForceNew: forceNew,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"arn": {
+						Type:         schema.TypeString,
+						ForceNew:     forceNew,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"catalog_id": {
+						Type:         schema.TypeString,
+						ForceNew:     forceNew,
+						Optional:     true,
+						ValidateFunc: verify.ValidAccountID,
+					},
+				},
+			},
+		},
+		"database": {
+			Type:     schema.TypeList,
+			ForceNew: forceNew,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"catalog_id": {
+						Type:         schema.TypeString,
+						ForceNew:     forceNew,
+						Optional:     true,
+						ValidateFunc: verify.ValidAccountID,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						ForceNew: forceNew,
+						Required: true,
+					},
+				},
+			},
+		},
+		"table": {
+			Type:     schema.TypeList,
+			ForceNew: forceNew,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"catalog_id": {
+						Type:         schema.TypeString,
+						ForceNew:     forceNew,
+						Optional:     true,
+						ValidateFunc: verify.ValidAccountID,
+					},
+					"database_name": {
+						Type:     schema.TypeString,
+						ForceNew: forceNew,
+						Required: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						ForceNew: forceNew,
+						Optional: true,
+					},
+					"wildcard": {
+						Type:     schema.TypeBool,
+						Default:  false,
+						ForceNew: forceNew,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"table_with_columns": {
+			Type:     schema.TypeList,
+			ForceNew: forceNew,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"catalog_id": {
+						Type:         schema.TypeString,
+						ForceNew:     forceNew,
+						Optional:     true,
+						ValidateFunc: verify.ValidAccountID,
+					},
+					"column_names": {
+						Type:     schema.TypeSet,
+						ForceNew: forceNew,
+						Optional: true,
+						Set:      schema.HashString,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+					"database_name": {
+						Type:     schema.TypeString,
+						ForceNew: forceNew,
+						Required: true,
+					},
+					"excluded_column_names": {
+						Type:     schema.TypeSet,
+						ForceNew: forceNew,
+						Optional: true,
+						Set:      schema.HashString,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+					"name": {
+						Type:     schema.TypeString,
+						ForceNew: forceNew,
+						Required: true,
+					},
+					"wildcard": {
+						Type:     schema.TypeBool,
+						Default:  false,
+						ForceNew: forceNew,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func permissionsGrantPermissionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"permissions": {
+			Type:     schema.TypeSet,
+			MinItems: 1,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+			},
+		},
+		"permissions_with_grant_option": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+			},
+		},
+	}
+}
+
+func expandPermissionsResource(tfMap map[string]interface{}) (*lakeformation.Resource, error) {
+	apiObject := &lakeformation.Resource{}
+	n := 0
+
+	if v, ok := tfMap["catalog_resource"].(bool); ok && v {
+		apiObject.Catalog = ExpandCatalogResource()
+		n++
+	}
+
+	if v, ok := tfMap["data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DataLocation = ExpandDataLocationResource(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if v, ok := tfMap["database"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v[0].(map[string]interface{}))
+		n++
+	}
+
+	if v, ok := tfMap["table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Table = ExpandTableResource(v[0].(map[string]interface{}))
+		n++
+
+		if apiObject.Table.Name == nil && apiObject.Table.TableWildcard == nil {
+			return nil, fmt.Errorf("table: one of name or wildcard must be specified")
+		}
+	}
+
+	if v, ok := tfMap["table_with_columns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TableWithColumns = expandTableColumnsResource(tfMap)
+		n++
+
+		if wildcard, ok := tfMap["wildcard"].(bool); ok && wildcard && len(apiObject.TableWithColumns.ColumnNames) > 0 {
+			return nil, fmt.Errorf("table_with_columns: only one of column_names or wildcard can be specified")
+		}
+
+		if apiObject.TableWithColumns.ColumnNames == nil && apiObject.TableWithColumns.ColumnWildcard == nil {
+			return nil, fmt.Errorf("table_with_columns: one of column_names or wildcard must be specified")
+		}
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("exactly one of catalog_resource, data_location, database, table, or table_with_columns must be specified")
+	}
+
+	return normalizePermissionsResource(apiObject), nil
+}
+
+// normalizePermissionsResource returns the resource in the shape used to compare grants.
+func normalizePermissionsResource(apiObject *lakeformation.Resource) *lakeformation.Resource {
+	if v := apiObject.TableWithColumns; v != nil && v.ColumnWildcard != nil && len(v.ColumnWildcard.ExcludedColumnNames) == 0 && len(v.ColumnNames) == 0 {
+		table := &lakeformation.TableResource{
+			CatalogId:    v.CatalogId,
+			DatabaseName: v.DatabaseName,
+			Name:         v.Name,
+		}
+
+		if aws.StringValue(v.Name) == TableNameAllTables {
+			table.Name = nil
+			table.TableWildcard = &lakeformation.TableWildcard{}
+		}
+
+		return &lakeformation.Resource{Table: table}
+	}
+
+	if v := apiObject.Table; v != nil && v.TableWildcard != nil && v.Name != nil {
+		return &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				CatalogId:     v.CatalogId,
+				DatabaseName:  v.DatabaseName,
+				TableWildcard: v.TableWildcard,
+			},
+		}
+	}
+
+	return apiObject
+}
+
+// permissionsResourceKey identifies a normalized resource. Catalog IDs that are not set default to
+// catalogID.
+func permissionsResourceKey(apiObject *lakeformation.Resource, catalogID string) string {
+	catalog := func(v *string) string {
+		if v := aws.StringValue(v); v != "" {
+			return v
+		}
+
+		return catalogID
+	}
+
+	switch {
+	case apiObject.Catalog != nil:
+		return fmt.Sprintf("catalog_resource catalog_id=%s", catalogID)
+	case apiObject.DataLocation != nil:
+		v := apiObject.DataLocation
+		return fmt.Sprintf("data_location catalog_id=%s arn=%s", catalog(v.CatalogId), aws.StringValue(v.ResourceArn))
+	case apiObject.Database != nil:
+		v := apiObject.Database
+		return fmt.Sprintf("database catalog_id=%s name=%s", catalog(v.CatalogId), aws.StringValue(v.Name))
+	case apiObject.Table != nil:
+		v := apiObject.Table
+
+		if v.TableWildcard != nil {
+			return fmt.Sprintf("table catalog_id=%s database_name=%s wildcard=true", catalog(v.CatalogId), aws.StringValue(v.DatabaseName))
+		}
+
+		return fmt.Sprintf("table catalog_id=%s database_name=%s name=%s", catalog(v.CatalogId), aws.StringValue(v.DatabaseName), aws.StringValue(v.Name))
+	case apiObject.TableWithColumns != nil:
+		v := apiObject.TableWithColumns
+		key := fmt.Sprintf("table_with_columns catalog_id=%s database_name=%s name=%s", catalog(v.CatalogId), aws.StringValue(v.DatabaseName), aws.StringValue(v.Name))
+
+		if v.ColumnWildcard != nil {
+			return fmt.Sprintf("%s excluded_column_names=%s", key, strings.Join(permissionsStringSet(aws.StringValueSlice(v.ColumnWildcard.ExcludedColumnNames)), ","))
+		}
+
+		return fmt.Sprintf("%s column_names=%s", key, strings.Join(permissionsStringSet(aws.StringValueSlice(v.ColumnNames)), ","))
+	}
+
+	return ""
+}
+
+func newPermissionsGrant(principal string, apiObject *lakeformation.Resource, permissions, permissionsWithGrantOption []string, catalogID string) *PermissionsGrant {
+	apiObject = normalizePermissionsResource(apiObject)
+	resourceKey := permissionsResourceKey(apiObject, catalogID)
+
+	return &PermissionsGrant{
+		Key:                        fmt.Sprintf("%s principal=%s", resourceKey, principal),
+		Principal:                  principal,
+		Resource:                   apiObject,
+		Permissions:                permissionsStringSet(permissions),
+		PermissionsWithGrantOption: permissionsStringSet(permissionsWithGrantOption),
+		resourceKey:                resourceKey,
+	}
+}
+
+// expandPermissionsGrant returns the grant configured by tfMap, which contains the permissions
+// and, unless given, the principal and resource of the grant.
+func expandPermissionsGrant(tfMap map[string]interface{}, principal string, apiObject *lakeformation.Resource, catalogID string) (*PermissionsGrant, error) {
+	if principal == "" {
+		principal = tfMap["principal"].(string)
+	}
+
+	if apiObject == nil {
+		var err error
+
+		if apiObject, err = expandPermissionsResource(tfMap); err != nil {
+			return nil, err
+		}
+	}
+
+	permissions := aws.StringValueSlice(flex.ExpandStringSet(tfMap["permissions"].(*schema.Set)))
+	var permissionsWithGrantOption []string
+
+	if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok {
+		permissionsWithGrantOption = aws.StringValueSlice(flex.ExpandStringSet(v))
+	}
+
+	if v := permissionsStringDifference(permissionsWithGrantOption, permissions); len(v) > 0 {
+		return nil, fmt.Errorf("permissions_with_grant_option must be a subset of permissions, %s not in permissions", strings.Join(v, ", "))
+	}
+
+	grant := newPermissionsGrant(principal, apiObject, permissions, permissionsWithGrantOption, catalogID)
+	grant.tfMap = tfMap
+
+	return grant, nil
+}
+
+// expandPermissionsGrants returns the grants configured by tfList keyed by Key.
+func expandPermissionsGrants(tfList []interface{}, principal string, apiObject *lakeformation.Resource, catalogID string) ([]*PermissionsGrant, map[string]*PermissionsGrant, error) {
+	grants := make([]*PermissionsGrant, 0, len(tfList))
+	byKey := make(map[string]*PermissionsGrant, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		grant, err := expandPermissionsGrant(tfMap, principal, apiObject, catalogID)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid grant: %w", err)
+		}
+
+		if _, ok := byKey[grant.Key]; ok {
+			return nil, nil, fmt.Errorf("duplicate grant (%s)", grant.Key)
+		}
+
+		grants = append(grants, grant)
+		byKey[grant.Key] = grant
+	}
+
+	return grants, byKey, nil
+}
+
+// NormalizePermissionsGrants returns the grants listed by ListPermissions keyed by Key.
+func NormalizePermissionsGrants(apiObjects []*lakeformation.PrincipalResourcePermissions, catalogID string) map[string]*PermissionsGrant {
+	grants := make(map[string]*PermissionsGrant)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Principal == nil || apiObject.Resource == nil {
+			continue
+		}
+
+		permissionsWithGrantOption := aws.StringValueSlice(apiObject.PermissionsWithGrantOption)
+		permissions := append(aws.StringValueSlice(apiObject.Permissions), permissionsWithGrantOption...)
+		grant := newPermissionsGrant(aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier), apiObject.Resource, permissions, permissionsWithGrantOption, catalogID)
+
+		if v, ok := grants[grant.Key]; ok {
+			v.Permissions = permissionsStringSet(append(v.Permissions, grant.Permissions...))
+			v.PermissionsWithGrantOption = permissionsStringSet(append(v.PermissionsWithGrantOption, grant.PermissionsWithGrantOption...))
+			continue
+		}
+
+		grants[grant.Key] = grant
+	}
+
+	return grants
+}
+
+// DiffPermissionsGrants returns the permissions to grant and to revoke that turn the actual grants
+// into the desired grants. Revocations are applied first: a permission that must lose only its
+// grant option is revoked and then granted again.
+func DiffPermissionsGrants(desired, actual map[string]*PermissionsGrant) ([]*PermissionsGrant, []*PermissionsGrant) {
+	var keys []string
+
+	for k := range desired {
+		keys = append(keys, k)
+	}
+
+	for k := range actual {
+		if _, ok := desired[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	var grants, revokes []*PermissionsGrant
+
+	for _, k := range keys {
+		want, have := desired[k], actual[k]
+		var wantPermissions, wantGrantOption, havePermissions, haveGrantOption []string
+
+		if want != nil {
+			wantPermissions, wantGrantOption = want.Permissions, want.PermissionsWithGrantOption
+		}
+
+		if have != nil {
+			havePermissions, haveGrantOption = have.Permissions, have.PermissionsWithGrantOption
+		}
+
+		revokeGrantOption := permissionsStringDifference(haveGrantOption, wantGrantOption)
+		revokePermissions := permissionsStringSet(append(permissionsStringDifference(havePermissions, wantPermissions), revokeGrantOption...))
+
+		if len(revokePermissions) > 0 {
+			revokes = append(revokes, &PermissionsGrant{
+				Key:                        k,
+				Principal:                  have.Principal,
+				Resource:                   have.Resource,
+				Permissions:                revokePermissions,
+				PermissionsWithGrantOption: revokeGrantOption,
+				resourceKey:                have.resourceKey,
+			})
+		}
+
+		if want == nil {
+			continue
+		}
+
+		grantGrantOption := permissionsStringDifference(wantGrantOption, permissionsStringDifference(haveGrantOption, revokeGrantOption))
+		grantPermissions := permissionsStringSet(append(permissionsStringDifference(wantPermissions, permissionsStringDifference(havePermissions, revokePermissions)), grantGrantOption...))
+
+		if len(grantPermissions) > 0 {
+			grants = append(grants, &PermissionsGrant{
+				Key:                        k,
+				Principal:                  want.Principal,
+				Resource:                   want.Resource,
+				Permissions:                grantPermissions,
+				PermissionsWithGrantOption: grantGrantOption,
+				resourceKey:                want.resourceKey,
+			})
+		}
+	}
+
+	return grants, revokes
+}
+
+// flattenPermissionsGrants returns the state of the actual grants. Grants that are also desired
+// keep their configured form so that equivalent resources don't show as drift; other grants are
+// flattened with flatten.
+func flattenPermissionsGrants(desired []*PermissionsGrant, actual map[string]*PermissionsGrant, flatten func(*PermissionsGrant) map[string]interface{}) []interface{} {
+	tfList := make([]interface{}, 0, len(actual))
+	seen := make(map[string]bool, len(desired))
+
+	for _, want := range desired {
+		have, ok := actual[want.Key]
+
+		if !ok || want.tfMap == nil {
+			continue
+		}
+
+		tfMap := make(map[string]interface{}, len(want.tfMap))
+
+		for k, v := range want.tfMap {
+			tfMap[k] = v
+		}
+
+		tfMap["permissions"] = have.Permissions
+		tfMap["permissions_with_grant_option"] = have.PermissionsWithGrantOption
+
+		tfList = append(tfList, tfMap)
+		seen[want.Key] = true
+	}
+
+	var keys []string
+
+	for k := range actual {
+		if !seen[k] {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		have := actual[k]
+		tfMap := flatten(have)
+
+		tfMap["permissions"] = have.Permissions
+		tfMap["permissions_with_grant_option"] = have.PermissionsWithGrantOption
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// flattenPermissionsResource flattens a normalized resource, leaving out catalog IDs that are the
+// same as catalogID.
+func flattenPermissionsResource(apiObject *lakeformation.Resource, catalogID string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"catalog_resource": apiObject.Catalog != nil,
+	}
+
+	flatten := func(k string, v map[string]interface{}) {
+		if v["catalog_id"] == catalogID {
+			delete(v, "catalog_id")
+		}
+
+		tfMap[k] = []interface{}{v}
+	}
+
+	if v := apiObject.DataLocation; v != nil {
+		flatten("data_location", flattenDataLocationResource(v))
+	}
+
+	if v := apiObject.Database; v != nil {
+		flatten("database", flattenDatabaseResource(v))
+	}
+
+	if v := apiObject.Table; v != nil {
+		flatten("table", flattenTableResource(v))
+	}
+
+	if v := apiObject.TableWithColumns; v != nil {
+		flatten("table_with_columns", flattenTableColumnsResource(v))
+	}
+
+	return tfMap
+}
+
+// permissionsScope is the set of grants managed by an authoritative permissions resource: the
+// grants listed by input for which include returns true. Catalog IDs that are not set default to
+// catalogID. When all grants in scope are on one resource, it is resource.
+type permissionsScope struct {
+	catalogID string
+	include   func(*PermissionsGrant) bool
+	input     *lakeformation.ListPermissionsInput
+	resource  *lakeformation.Resource
+}
+
+// listPermissionsGrants returns the grants in scope.
+func listPermissionsGrants(conn *lakeformation.LakeFormation, scope *permissionsScope) (map[string]*PermissionsGrant, error) {
+	var permissions []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.ListPermissionsPages(scope.input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		permissions = append(permissions, page.PrincipalResourcePermissions...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	grants := NormalizePermissionsGrants(permissions, scope.catalogID)
+
+	for k, v := range grants {
+		if !scope.include(v) {
+			delete(grants, k)
+		}
+	}
+
+	return grants, nil
+}
+
+// updatePermissionsGrants revokes and grants permissions so that the grants in scope become the
+// desired grants, then waits for ListPermissions to agree.
+func updatePermissionsGrants(conn *lakeformation.LakeFormation, scope *permissionsScope, desired map[string]*PermissionsGrant) error {
+	actual, err := listPermissionsGrants(conn, scope)
+
+	if err != nil {
+		return fmt.Errorf("error listing Lake Formation permissions: %w", err)
+	}
+
+	grants, revokes := DiffPermissionsGrants(desired, actual)
+
+	for _, grant := range revokes {
+		if err := revokePermissionsGrant(conn, scope.input.CatalogId, grant); err != nil {
+			return err
+		}
+	}
+
+	for _, grant := range grants {
+		if err := grantPermissionsGrant(conn, scope.input.CatalogId, grant); err != nil {
+			return err
+		}
+	}
+
+	if len(grants) == 0 && len(revokes) == 0 {
+		return nil
+	}
+
+	err = resource.Retry(permissionsReadyTimeout, func() *resource.RetryError {
+		actual, err := listPermissionsGrants(conn, scope)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if grants, revokes := DiffPermissionsGrants(desired, actual); len(grants) > 0 || len(revokes) > 0 {
+			return resource.RetryableError(fmt.Errorf("%d grants and %d revocations not yet listed", len(grants), len(revokes)))
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		actual, err = listPermissionsGrants(conn, scope)
+
+		if err == nil {
+			if grants, revokes := DiffPermissionsGrants(desired, actual); len(grants) > 0 || len(revokes) > 0 {
+				err = fmt.Errorf("%d grants and %d revocations not listed", len(grants), len(revokes))
+			}
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Lake Formation permissions to update: %w", err)
+	}
+
+	return nil
+}
+
+func grantPermissionsGrant(conn *lakeformation.LakeFormation, catalogID *string, grant *PermissionsGrant) error {
+	input := &lakeformation.GrantPermissionsInput{
+		CatalogId:   catalogID,
+		Permissions: aws.StringSlice(grant.Permissions),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(grant.Principal),
+		},
+		Resource: grant.Resource,
+	}
+
+	if len(grant.PermissionsWithGrantOption) > 0 {
+		input.PermissionsWithGrantOption = aws.StringSlice(grant.PermissionsWithGrantOption)
+	}
+
+	log.Printf("[DEBUG] Granting Lake Formation permissions: %s", input)
+	err := resource.Retry(IAMPropagationTimeout, func() *resource.RetryError {
+		_, err := conn.GrantPermissions(input)
+
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "Grantee has no permissions") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "register the S3 path") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, "AccessDeniedException", "is not authorized to access requested permissions") {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.GrantPermissions(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error granting Lake Formation permissions (%s): %w", grant.Key, err)
+	}
+
+	return nil
+}
+
+func revokePermissionsGrant(conn *lakeformation.LakeFormation, catalogID *string, grant *PermissionsGrant) error {
+	input := &lakeformation.RevokePermissionsInput{
+		CatalogId:   catalogID,
+		Permissions: aws.StringSlice(grant.Permissions),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(grant.Principal),
+		},
+		Resource: grant.Resource,
+	}
+
+	if len(grant.PermissionsWithGrantOption) > 0 {
+		input.PermissionsWithGrantOption = aws.StringSlice(grant.PermissionsWithGrantOption)
+	}
+
+	log.Printf("[DEBUG] Revoking Lake Formation permissions: %s", input)
+	err := resource.Retry(permissionsDeleteRetryTimeout, func() *resource.RetryError {
+		_, err := conn.RevokePermissions(input)
+
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "register the S3 path") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, "AccessDeniedException", "is not authorized to access requested permissions") {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.RevokePermissions(input)
+	}
+
+	if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "No permissions revoked. Grantee") {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "cannot grant/revoke permission on non-existent column") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Lake Formation permissions (%s): %w", grant.Key, err)
+	}
+
+	return nil
+}
+
+// permissionsStringSet returns the sorted, distinct values of s.
+func permissionsStringSet(s []string) []string {
+	set := make([]string, 0, len(s))
+	seen := make(map[string]bool, len(s))
+
+	for _, v := range s {
+		if !seen[v] {
+			seen[v] = true
+			set = append(set, v)
+		}
+	}
+
+	sort.Strings(set)
+
+	return set
+}
+
+// permissionsStringDifference returns the sorted, distinct values of s1 that are not in s2.
+func permissionsStringDifference(s1, s2 []string) []string {
+	exclude := make(map[string]bool, len(s2))
+
+	for _, v := range s2 {
+		exclude[v] = true
+	}
+
+	var difference []string
+
+	for _, v := range s1 {
+		if !exclude[v] {
+			difference = append(difference, v)
+		}
+	}
+
+	return permissionsStringSet(difference)
+}
@@ -0,0 +1,302 @@
+package lakeformation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+)
+
+func TestNormalizePermissionsGrants(t *testing.T) {
+	accountID := "481516234248"
+	dbName := "Hiliji"
+	tableName := "Ladocmoc"
+	principal := "arn:aws:iam::481516234248:role/Kandiblu"
+	altPrincipal := "arn:aws:iam::481516234248:role/Bilhun"
+
+	testCases := []struct {
+		Name        string
+		Permissions []*lakeformation.PrincipalResourcePermissions
+		Expected    map[string][2][]string
+	}{
+		{
+			Name: "table select",
+			Permissions: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionAlter, lakeformation.PermissionDrop}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+					Resource: &lakeformation.Resource{
+						Table: &lakeformation.TableResource{
+							CatalogId:    aws.String(accountID),
+							DatabaseName: aws.String(dbName),
+							Name:         aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+					Resource: &lakeformation.Resource{
+						TableWithColumns: &lakeformation.TableWithColumnsResource{
+							CatalogId:      aws.String(accountID),
+							ColumnWildcard: &lakeformation.ColumnWildcard{},
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String(tableName),
+						},
+					},
+				},
+			},
+			Expected: map[string][2][]string{
+				"table catalog_id=481516234248 database_name=Hiliji name=Ladocmoc principal=" + principal: {
+					{lakeformation.PermissionAlter, lakeformation.PermissionDrop, lakeformation.PermissionSelect},
+					{},
+				},
+			},
+		},
+		{
+			Name: "table wildcard",
+			Permissions: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+					Resource: &lakeformation.Resource{
+						Table: &lakeformation.TableResource{
+							CatalogId:     aws.String(accountID),
+							DatabaseName:  aws.String(dbName),
+							Name:          aws.String(tflakeformation.TableNameAllTables),
+							TableWildcard: &lakeformation.TableWildcard{},
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+					Resource: &lakeformation.Resource{
+						TableWithColumns: &lakeformation.TableWithColumnsResource{
+							CatalogId:      aws.String(accountID),
+							ColumnWildcard: &lakeformation.ColumnWildcard{},
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String(tflakeformation.TableNameAllTables),
+						},
+					},
+				},
+			},
+			Expected: map[string][2][]string{
+				"table catalog_id=481516234248 database_name=Hiliji wildcard=true principal=" + principal: {
+					{lakeformation.PermissionDescribe, lakeformation.PermissionSelect},
+					{},
+				},
+			},
+		},
+		{
+			Name: "table with columns",
+			Permissions: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+					Resource: &lakeformation.Resource{
+						TableWithColumns: &lakeformation.TableWithColumnsResource{
+							CatalogId: aws.String(accountID),
+							ColumnWildcard: &lakeformation.ColumnWildcard{
+								ExcludedColumnNames: aws.StringSlice([]string{"value", "id"}),
+							},
+							DatabaseName: aws.String(dbName),
+							Name:         aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+					Resource: &lakeformation.Resource{
+						TableWithColumns: &lakeformation.TableWithColumnsResource{
+							CatalogId:    aws.String(accountID),
+							ColumnNames:  aws.StringSlice([]string{"value", "id"}),
+							DatabaseName: aws.String(dbName),
+							Name:         aws.String(tableName),
+						},
+					},
+				},
+			},
+			Expected: map[string][2][]string{
+				"table_with_columns catalog_id=481516234248 database_name=Hiliji name=Ladocmoc excluded_column_names=id,value principal=" + principal: {
+					{lakeformation.PermissionSelect},
+					{lakeformation.PermissionSelect},
+				},
+				"table_with_columns catalog_id=481516234248 database_name=Hiliji name=Ladocmoc column_names=id,value principal=" + principal: {
+					{lakeformation.PermissionSelect},
+					{},
+				},
+			},
+		},
+		{
+			Name: "grant option",
+			Permissions: []*lakeformation.PrincipalResourcePermissions{
+				{
+					PermissionsWithGrantOption: aws.StringSlice([]string{lakeformation.PermissionCreateTable}),
+					Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+					Resource: &lakeformation.Resource{
+						Database: &lakeformation.DatabaseResource{
+							Name: aws.String(dbName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionAll}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(tflakeformation.IAMAllowedPrincipals)},
+					Resource: &lakeformation.Resource{
+						Database: &lakeformation.DatabaseResource{
+							CatalogId: aws.String(accountID),
+							Name:      aws.String(dbName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionCreateDatabase}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(altPrincipal)},
+					Resource: &lakeformation.Resource{
+						Catalog: &lakeformation.CatalogResource{},
+					},
+				},
+			},
+			Expected: map[string][2][]string{
+				"database catalog_id=481516234248 name=Hiliji principal=" + principal: {
+					{lakeformation.PermissionCreateTable},
+					{lakeformation.PermissionCreateTable},
+				},
+				"database catalog_id=481516234248 name=Hiliji principal=IAM_ALLOWED_PRINCIPALS": {
+					{lakeformation.PermissionAll},
+					{},
+				},
+				"catalog_resource catalog_id=481516234248 principal=" + altPrincipal: {
+					{lakeformation.PermissionCreateDatabase},
+					{},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			grants := tflakeformation.NormalizePermissionsGrants(testCase.Permissions, accountID)
+			got := make(map[string][2][]string, len(grants))
+
+			for k, v := range grants {
+				got[k] = [2][]string{v.Permissions, v.PermissionsWithGrantOption}
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDiffPermissionsGrants(t *testing.T) {
+	accountID := "481516234248"
+	principal := "arn:aws:iam::481516234248:role/Kandiblu"
+	altPrincipal := "arn:aws:iam::481516234248:role/Bilhun"
+
+	grants := func(principals map[string][2][]string) map[string]*tflakeformation.PermissionsGrant {
+		var apiObjects []*lakeformation.PrincipalResourcePermissions
+
+		for principal, permissions := range principals {
+			apiObjects = append(apiObjects, &lakeformation.PrincipalResourcePermissions{
+				Permissions:                aws.StringSlice(permissions[0]),
+				PermissionsWithGrantOption: aws.StringSlice(permissions[1]),
+				Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource: &lakeformation.Resource{
+					Database: &lakeformation.DatabaseResource{
+						Name: aws.String("Hiliji"),
+					},
+				},
+			})
+		}
+
+		return tflakeformation.NormalizePermissionsGrants(apiObjects, accountID)
+	}
+
+	testCases := []struct {
+		Name    string
+		Desired map[string][2][]string
+		Actual  map[string][2][]string
+		Grants  map[string][2][]string
+		Revokes map[string][2][]string
+	}{
+		{
+			Name:    "no changes",
+			Desired: map[string][2][]string{principal: {{"ALTER", "CREATE_TABLE"}, {"CREATE_TABLE"}}},
+			Actual:  map[string][2][]string{principal: {{"ALTER"}, {"CREATE_TABLE"}}},
+		},
+		{
+			Name:    "grant",
+			Desired: map[string][2][]string{principal: {{"ALTER", "CREATE_TABLE"}, nil}},
+			Grants:  map[string][2][]string{principal: {{"ALTER", "CREATE_TABLE"}, {}}},
+		},
+		{
+			Name:    "grant permission",
+			Desired: map[string][2][]string{principal: {{"ALTER", "CREATE_TABLE"}, nil}},
+			Actual:  map[string][2][]string{principal: {{"ALTER"}, nil}},
+			Grants:  map[string][2][]string{principal: {{"CREATE_TABLE"}, {}}},
+		},
+		{
+			Name:    "grant grant option",
+			Desired: map[string][2][]string{principal: {{"ALTER", "CREATE_TABLE"}, {"CREATE_TABLE"}}},
+			Actual:  map[string][2][]string{principal: {{"ALTER", "CREATE_TABLE"}, nil}},
+			Grants:  map[string][2][]string{principal: {{"CREATE_TABLE"}, {"CREATE_TABLE"}}},
+		},
+		{
+			Name:    "revoke permission",
+			Desired: map[string][2][]string{principal: {{"ALTER"}, nil}},
+			Actual:  map[string][2][]string{principal: {{"ALTER", "DROP"}, nil}},
+			Revokes: map[string][2][]string{principal: {{"DROP"}, {}}},
+		},
+		{
+			Name:    "revoke grant option",
+			Desired: map[string][2][]string{principal: {{"ALTER", "CREATE_TABLE"}, nil}},
+			Actual:  map[string][2][]string{principal: {{"ALTER"}, {"CREATE_TABLE"}}},
+			Grants:  map[string][2][]string{principal: {{"CREATE_TABLE"}, {}}},
+			Revokes: map[string][2][]string{principal: {{"CREATE_TABLE"}, {"CREATE_TABLE"}}},
+		},
+		{
+			Name:    "revoke unexpected",
+			Desired: map[string][2][]string{principal: {{"ALTER"}, nil}},
+			Actual:  map[string][2][]string{principal: {{"ALTER"}, nil}, altPrincipal: {{"ALL"}, {"ALL"}}},
+			Revokes: map[string][2][]string{altPrincipal: {{"ALL"}, {"ALL"}}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			desired, actual := grants(testCase.Desired), grants(testCase.Actual)
+			gotGrants, gotRevokes := tflakeformation.DiffPermissionsGrants(desired, actual)
+
+			for _, v := range []struct {
+				Got      []*tflakeformation.PermissionsGrant
+				Expected map[string][2][]string
+			}{
+				{gotGrants, testCase.Grants},
+				{gotRevokes, testCase.Revokes},
+			} {
+				got := make(map[string][2][]string, len(v.Got))
+
+				for _, grant := range v.Got {
+					got[grant.Principal] = [2][]string{grant.Permissions, grant.PermissionsWithGrantOption}
+				}
+
+				expected := v.Expected
+
+				if expected == nil {
+					expected = map[string][2][]string{}
+				}
+
+				if !reflect.DeepEqual(got, expected) {
+					t.Errorf("got %v, expected %v", got, expected)
+				}
+			}
+		})
+	}
+}
@@ -45,6 +45,16 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"wildcardSelectOnly":      testAccPermissions_twcWildcardSelectOnly,
 			"wildcardSelectPlus":      testAccPermissions_twcWildcardSelectPlus,
 		},
+		"PrincipalPermissions": {
+			"basic":            testAccPrincipalPermissions_basic,
+			"drift":            testAccPrincipalPermissions_drift,
+			"tableWithColumns": testAccPrincipalPermissions_tableWithColumns,
+		},
+		"ResourcePermissions": {
+			"basic":                testAccResourcePermissions_basic,
+			"drift":                testAccResourcePermissions_drift,
+			"iamAllowedPrincipals": testAccResourcePermissions_iamAllowedPrincipals,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,151 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePrincipalPermissions() *schema.Resource {
+	grantSchema := permissionsResourceSchema(false)
+
+	for k, v := range permissionsGrantPermissionsSchema() {
+		grantSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Create: resourcePrincipalPermissionsCreate,
+		Read:   resourcePrincipalPermissionsRead,
+		Update: resourcePrincipalPermissionsUpdate,
+		Delete: resourcePrincipalPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"grant": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: grantSchema,
+				},
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validPrincipal,
+			},
+		},
+	}
+}
+
+func resourcePrincipalPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(fmt.Sprintf("%s:%s", d.Get("catalog_id").(string), d.Get("principal").(string)))))
+
+	return resourcePrincipalPermissionsUpdate(d, meta)
+}
+
+func resourcePrincipalPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+	scope := principalPermissionsScope(d, meta)
+
+	desired, _, err := expandPermissionsGrants(d.Get("grant").(*schema.Set).List(), d.Get("principal").(string), nil, scope.catalogID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation principal permissions (%s): %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Reading Lake Formation permissions: %s", scope.input)
+	actual, err := listPermissionsGrants(conn, scope)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation principal permissions (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("grant", flattenPermissionsGrants(desired, actual, func(grant *PermissionsGrant) map[string]interface{} {
+		return flattenPermissionsResource(grant.Resource, scope.catalogID)
+	})); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePrincipalPermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+	scope := principalPermissionsScope(d, meta)
+
+	_, desired, err := expandPermissionsGrants(d.Get("grant").(*schema.Set).List(), d.Get("principal").(string), nil, scope.catalogID)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lake Formation principal permissions (%s): %w", d.Id(), err)
+	}
+
+	if err := updatePermissionsGrants(conn, scope, desired); err != nil {
+		return fmt.Errorf("error updating Lake Formation principal permissions (%s): %w", d.Id(), err)
+	}
+
+	return resourcePrincipalPermissionsRead(d, meta)
+}
+
+func resourcePrincipalPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+	scope := principalPermissionsScope(d, meta)
+
+	_, grants, err := expandPermissionsGrants(d.Get("grant").(*schema.Set).List(), d.Get("principal").(string), nil, scope.catalogID)
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation principal permissions (%s): %w", d.Id(), err)
+	}
+
+	_, revokes := DiffPermissionsGrants(nil, grants)
+
+	for _, grant := range revokes {
+		err := revokePermissionsGrant(conn, scope.input.CatalogId, grant)
+
+		// The grant's resource no longer exists. Revoke the remaining grants.
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Lake Formation principal permissions (%s): %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// principalPermissionsScope returns the scope of the principal's grants.
+func principalPermissionsScope(d *schema.ResourceData, meta interface{}) *permissionsScope {
+	// ListPermissions requires a resource when a principal is given, so list all permissions in
+	// the catalog and filter them.
+	input := &lakeformation.ListPermissionsInput{}
+	catalogID := meta.(*conns.AWSClient).AccountID
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+		catalogID = v.(string)
+	}
+
+	principal := d.Get("principal").(string)
+
+	return &permissionsScope{
+		catalogID: catalogID,
+		include: func(grant *PermissionsGrant) bool {
+			return grant.Principal == principal
+		},
+		input: input,
+	}
+}
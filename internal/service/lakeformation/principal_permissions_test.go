@@ -0,0 +1,337 @@
+package lakeformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccPrincipalPermissions_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_principal_permissions.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPrincipalPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"database.#":                      "1",
+						"database.0.name":                 rName,
+						"permissions.#":                   "2",
+						"permissions_with_grant_option.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"table.#":               "1",
+						"table.0.database_name": rName,
+						"table.0.name":          rName,
+						"permissions.#":         "2",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "grant.*.permissions.*", lakeformation.PermissionSelect),
+				),
+			},
+			{
+				Config: testAccPrincipalPermissionsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"database.#":                      "1",
+						"database.0.name":                 rName,
+						"permissions.#":                   "1",
+						"permissions_with_grant_option.#": "0",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "grant.*.permissions.*", lakeformation.PermissionCreateTable),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPermissions_drift(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_principal_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPrincipalPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPermissionsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					testAccCheckPermissionsGrantOutOfBand("aws_iam_role.test", "aws_glue_catalog_database.test", lakeformation.PermissionDrop),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPrincipalPermissionsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"permissions.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "grant.*.permissions.*", lakeformation.PermissionCreateTable),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPermissions_tableWithColumns(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_principal_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPrincipalPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPermissionsConfig_tableWithColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"table_with_columns.#":          "1",
+						"table_with_columns.0.wildcard": "true",
+						"permissions.#":                 "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"table_with_columns.#":                "1",
+						"table_with_columns.0.column_names.#": "2",
+						"permissions.#":                       "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPrincipalPermissionsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_principal_permissions" {
+			continue
+		}
+
+		input := &lakeformation.ListPermissionsInput{}
+
+		if v := rs.Primary.Attributes["catalog_id"]; v != "" {
+			input.CatalogId = aws.String(v)
+		}
+
+		permCount := 0
+
+		err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+			for _, permission := range page.PrincipalResourcePermissions {
+				if aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) == rs.Primary.Attributes["principal"] {
+					permCount++
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error listing Lake Formation permissions (%s): %w", rs.Primary.ID, err)
+		}
+
+		if permCount != 0 {
+			return fmt.Errorf("acceptance test: Lake Formation principal permissions (%s) still exist: %d", rs.Primary.ID, permCount)
+		}
+	}
+
+	return nil
+}
+
+// testAccCheckPermissionsGrantOutOfBand grants a database permission to a role outside of
+// Terraform.
+func testAccCheckPermissionsGrantOutOfBand(roleResourceName, databaseResourceName, permission string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		role, ok := s.RootModule().Resources[roleResourceName]
+
+		if !ok {
+			return fmt.Errorf("acceptance test: resource not found: %s", roleResourceName)
+		}
+
+		database, ok := s.RootModule().Resources[databaseResourceName]
+
+		if !ok {
+			return fmt.Errorf("acceptance test: resource not found: %s", databaseResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+		_, err := conn.GrantPermissions(&lakeformation.GrantPermissionsInput{
+			Permissions: aws.StringSlice([]string{permission}),
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(role.Primary.Attributes["arn"]),
+			},
+			Resource: &lakeformation.Resource{
+				Database: &lakeformation.DatabaseResource{
+					Name: aws.String(database.Primary.Attributes["name"]),
+				},
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error granting Lake Formation permissions: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccPrincipalPermissionsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "value"
+      type = "double"
+    }
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+`, rName)
+}
+
+func testAccPrincipalPermissionsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalPermissionsConfig_base(rName), `
+resource "aws_lakeformation_principal_permissions" "test" {
+  principal = aws_iam_role.test.arn
+
+  grant {
+    permissions                   = ["ALTER", "CREATE_TABLE"]
+    permissions_with_grant_option = ["CREATE_TABLE"]
+
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  grant {
+    permissions = ["DESCRIBE", "SELECT"]
+
+    table {
+      database_name = aws_glue_catalog_table.test.database_name
+      name          = aws_glue_catalog_table.test.name
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccPrincipalPermissionsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalPermissionsConfig_base(rName), `
+resource "aws_lakeformation_principal_permissions" "test" {
+  principal = aws_iam_role.test.arn
+
+  grant {
+    permissions = ["CREATE_TABLE"]
+
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccPrincipalPermissionsConfig_tableWithColumns(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalPermissionsConfig_base(rName), `
+resource "aws_lakeformation_principal_permissions" "test" {
+  principal = aws_iam_role.test.arn
+
+  grant {
+    permissions = ["DESCRIBE", "SELECT"]
+
+    table_with_columns {
+      database_name = aws_glue_catalog_table.test.database_name
+      name          = aws_glue_catalog_table.test.name
+      wildcard      = true
+    }
+  }
+
+  grant {
+    permissions = ["SELECT"]
+
+    table_with_columns {
+      database_name = aws_glue_catalog_table.test.database_name
+      name          = aws_glue_catalog_table.test.name
+      column_names  = ["event", "timestamp"]
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
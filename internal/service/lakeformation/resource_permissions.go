@@ -0,0 +1,245 @@
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourcePermissions() *schema.Resource {
+	grantSchema := permissionsGrantPermissionsSchema()
+	grantSchema["principal"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validPrincipal,
+	}
+
+	resourceSchema := permissionsResourceSchema(true)
+
+	for _, v := range resourceSchema {
+		v.ExactlyOneOf = []string{
+			"catalog_resource",
+			"data_location",
+			"database",
+			"table",
+			"table_with_columns",
+		}
+	}
+
+	resourceSchema["catalog_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		ForceNew:     true,
+		Optional:     true,
+		ValidateFunc: verify.ValidAccountID,
+	}
+	resourceSchema["grant"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: grantSchema,
+		},
+	}
+	resourceSchema["include_iam_allowed_principals"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Default:  false,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceResourcePermissionsCreate,
+		Read:   resourceResourcePermissionsRead,
+		Update: resourceResourcePermissionsUpdate,
+		Delete: resourceResourcePermissionsDelete,
+
+		CustomizeDiff: resourceResourcePermissionsCustomizeDiff,
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceResourcePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	scope, err := resourcePermissionsScope(d, meta)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation resource permissions: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(scope.input.String())))
+
+	return resourceResourcePermissionsUpdate(d, meta)
+}
+
+func resourceResourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+	scope, err := resourcePermissionsScope(d, meta)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation resource permissions (%s): %w", d.Id(), err)
+	}
+
+	desired, _, err := expandPermissionsGrants(d.Get("grant").(*schema.Set).List(), "", scope.resource, scope.catalogID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation resource permissions (%s): %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Reading Lake Formation permissions: %s", scope.input)
+	actual, err := listPermissionsGrants(conn, scope)
+
+	if !d.IsNewResource() {
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+			log.Printf("[WARN] Lake Formation resource permissions (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if tfawserr.ErrMessageContains(err, "AccessDeniedException", "Resource does not exist") {
+			log.Printf("[WARN] Lake Formation resource permissions (%s) not found, removing from state: %s", d.Id(), err)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation resource permissions (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("grant", flattenPermissionsGrants(desired, actual, func(grant *PermissionsGrant) map[string]interface{} {
+		return map[string]interface{}{
+			"principal": grant.Principal,
+		}
+	})); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceResourcePermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+	scope, err := resourcePermissionsScope(d, meta)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lake Formation resource permissions (%s): %w", d.Id(), err)
+	}
+
+	_, desired, err := expandPermissionsGrants(d.Get("grant").(*schema.Set).List(), "", scope.resource, scope.catalogID)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lake Formation resource permissions (%s): %w", d.Id(), err)
+	}
+
+	if err := updatePermissionsGrants(conn, scope, desired); err != nil {
+		return fmt.Errorf("error updating Lake Formation resource permissions (%s): %w", d.Id(), err)
+	}
+
+	return resourceResourcePermissionsRead(d, meta)
+}
+
+func resourceResourcePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+	scope, err := resourcePermissionsScope(d, meta)
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation resource permissions (%s): %w", d.Id(), err)
+	}
+
+	_, grants, err := expandPermissionsGrants(d.Get("grant").(*schema.Set).List(), "", scope.resource, scope.catalogID)
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation resource permissions (%s): %w", d.Id(), err)
+	}
+
+	_, revokes := DiffPermissionsGrants(nil, grants)
+
+	for _, grant := range revokes {
+		err := revokePermissionsGrant(conn, scope.input.CatalogId, grant)
+
+		// The grant's resource no longer exists. Revoke the remaining grants.
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Lake Formation resource permissions (%s): %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceResourcePermissionsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("include_iam_allowed_principals").(bool) {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("grant").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if ok && tfMap["principal"] == IAMAllowedPrincipals {
+			return fmt.Errorf("grants to %s require include_iam_allowed_principals to be true", IAMAllowedPrincipals)
+		}
+	}
+
+	return nil
+}
+
+// resourcePermissionsScope returns the scope of the resource's grants.
+func resourcePermissionsScope(d *schema.ResourceData, meta interface{}) (*permissionsScope, error) {
+	tfMap := make(map[string]interface{})
+
+	for _, k := range []string{"catalog_resource", "data_location", "database", "table", "table_with_columns"} {
+		tfMap[k] = d.Get(k)
+	}
+
+	resource, err := expandPermissionsResource(tfMap)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &lakeformation.ListPermissionsInput{
+		Resource: resource,
+	}
+	catalogID := meta.(*conns.AWSClient).AccountID
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+		catalogID = v.(string)
+	}
+
+	if v := resource.TableWithColumns; v != nil {
+		// can't ListPermissions for TableWithColumns, so use Table instead
+		input.Resource = &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				CatalogId:    v.CatalogId,
+				DatabaseName: v.DatabaseName,
+				Name:         v.Name,
+			},
+		}
+	}
+
+	resourceKey := permissionsResourceKey(resource, catalogID)
+	includeIAMAllowedPrincipals := d.Get("include_iam_allowed_principals").(bool)
+
+	return &permissionsScope{
+		catalogID: catalogID,
+		include: func(grant *PermissionsGrant) bool {
+			if grant.Principal == IAMAllowedPrincipals && !includeIAMAllowedPrincipals {
+				return false
+			}
+
+			return grant.resourceKey == resourceKey
+		},
+		input:    input,
+		resource: resource,
+	}, nil
+}
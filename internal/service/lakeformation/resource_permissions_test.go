@@ -0,0 +1,267 @@
+package lakeformation_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+)
+
+func testAccResourcePermissions_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourcePermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "database.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "include_iam_allowed_principals", "false"),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"permissions.#":                   "2",
+						"permissions_with_grant_option.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"permissions.#":                   "1",
+						"permissions_with_grant_option.#": "0",
+					}),
+				),
+			},
+			{
+				Config: testAccResourcePermissionsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "grant.*.principal", "aws_iam_role.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePermissions_drift(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourcePermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					testAccCheckPermissionsGrantOutOfBand("aws_iam_role.test", "aws_glue_catalog_database.test", lakeformation.PermissionDescribe),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourcePermissionsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "grant.*.principal", "aws_iam_role.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePermissions_iamAllowedPrincipals(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourcePermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePermissionsConfig_iamAllowedPrincipals(rName, false),
+				ExpectError: regexp.MustCompile(`grants to IAM_ALLOWED_PRINCIPALS require include_iam_allowed_principals`),
+			},
+			{
+				Config: testAccResourcePermissionsConfig_iamAllowedPrincipals(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "include_iam_allowed_principals", "true"),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"principal":     tflakeformation.IAMAllowedPrincipals,
+						"permissions.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "grant.*.permissions.*", lakeformation.PermissionDescribe),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourcePermissionsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_resource_permissions" {
+			continue
+		}
+
+		input := &lakeformation.ListPermissionsInput{
+			Resource: &lakeformation.Resource{
+				Database: &lakeformation.DatabaseResource{
+					Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+				},
+			},
+		}
+
+		permCount := 0
+
+		err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+			for _, permission := range page.PrincipalResourcePermissions {
+				if aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) != tflakeformation.IAMAllowedPrincipals {
+					permCount++
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error listing Lake Formation permissions (%s): %w", rs.Primary.ID, err)
+		}
+
+		if permCount != 0 {
+			return fmt.Errorf("acceptance test: Lake Formation resource permissions (%s) still exist: %d", rs.Primary.ID, permCount)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourcePermissionsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+`, rName)
+}
+
+func testAccResourcePermissionsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResourcePermissionsConfig_base(rName), `
+resource "aws_lakeformation_resource_permissions" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  grant {
+    principal                     = aws_iam_role.test.arn
+    permissions                   = ["ALTER", "CREATE_TABLE"]
+    permissions_with_grant_option = ["CREATE_TABLE"]
+  }
+
+  grant {
+    principal   = aws_iam_role.test2.arn
+    permissions = ["DESCRIBE"]
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccResourcePermissionsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccResourcePermissionsConfig_base(rName), `
+resource "aws_lakeformation_resource_permissions" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  grant {
+    principal   = aws_iam_role.test2.arn
+    permissions = ["DESCRIBE"]
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccResourcePermissionsConfig_iamAllowedPrincipals(rName string, include bool) string {
+	return acctest.ConfigCompose(testAccResourcePermissionsConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_resource_permissions" "test" {
+  include_iam_allowed_principals = %[1]t
+
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  grant {
+    principal   = "IAM_ALLOWED_PRINCIPALS"
+    permissions = ["DESCRIBE"]
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, include))
+}
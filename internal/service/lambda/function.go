@@ -62,27 +62,54 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_dir"},
 			},
 			"s3_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_dir"},
+			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_code_hash"},
+			},
+			"source_excludes": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_includes": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_s3_key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_s3_bucket"},
 			},
 			"package_type": {
 				Type:         schema.TypeString,
@@ -349,6 +376,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateSourceCodeHashFromSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -371,6 +399,30 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateSourceCodeHashFromSourceDir packages source_dir so that the function code is only updated when
+// the package changes.
+func updateSourceCodeHashFromSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := d.GetOk("source_dir"); !ok {
+		return nil
+	}
+
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_includes") || !d.NewValueKnown("source_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	content, err := buildFunctionPackageFromSourceDir(d)
+
+	if err != nil {
+		return err
+	}
+
+	if hash := functionPackageHash(content); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := hasConfigChanges(d)
 	functionCodeUpdated := needsFunctionCodeUpdate(d)
@@ -424,13 +476,24 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 	imageUri, hasImageUri := d.GetOk("image_uri")
+	_, hasSourceDir := d.GetOk("source_dir")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri {
-		return errors.New("filename, s3_* or image_uri attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri && !hasSourceDir {
+		return errors.New("filename, s3_*, image_uri or source_dir attributes must be set")
 	}
 
 	var functionCode *lambda.FunctionCode
-	if hasFilename {
+	if hasSourceDir {
+		// Grab an exclusive lock so that we're only reading one function into
+		// memory at a time.
+		conns.GlobalMutexKV.Lock(keyMutex)
+		defer conns.GlobalMutexKV.Unlock(keyMutex)
+		code, err := functionCodeFromSourceDir(d, meta)
+		if err != nil {
+			return err
+		}
+		functionCode = code
+	} else if hasFilename {
 		// Grab an exclusive lock so that we're only reading one function into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
@@ -1210,7 +1273,19 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if v, ok := d.GetOk("filename"); ok {
+		if _, ok := d.GetOk("source_dir"); ok {
+			// Grab an exclusive lock so that we're only reading one function into
+			// memory at a time.
+			conns.GlobalMutexKV.Lock(keyMutex)
+			defer conns.GlobalMutexKV.Unlock(keyMutex)
+			code, err := functionCodeFromSourceDir(d, meta)
+			if err != nil {
+				return err
+			}
+			codeReq.ZipFile = code.ZipFile
+			codeReq.S3Bucket = code.S3Bucket
+			codeReq.S3Key = code.S3Key
+		} else if v, ok := d.GetOk("filename"); ok {
 			// Grab an exclusive lock so that we're only reading one function into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
@@ -1332,6 +1407,47 @@ func loadFileContent(v string) ([]byte, error) {
 	return fileContent, nil
 }
 
+func buildFunctionPackageFromSourceDir(d interface{ Get(string) interface{} }) ([]byte, error) {
+	return buildFunctionPackage(
+		d.Get("source_dir").(string),
+		aws.StringValueSlice(flex.ExpandStringList(d.Get("source_includes").([]interface{}))),
+		aws.StringValueSlice(flex.ExpandStringList(d.Get("source_excludes").([]interface{}))),
+	)
+}
+
+// functionCodeFromSourceDir packages source_dir. Packages that are too large to upload directly
+// are uploaded to source_s3_bucket.
+func functionCodeFromSourceDir(d *schema.ResourceData, meta interface{}) (*lambda.FunctionCode, error) {
+	content, err := buildFunctionPackageFromSourceDir(d)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) <= functionZipFileMaxSize {
+		return &lambda.FunctionCode{
+			ZipFile: content,
+		}, nil
+	}
+
+	bucket, ok := d.GetOk("source_s3_bucket")
+
+	if !ok {
+		return nil, fmt.Errorf("Lambda deployment package of %s is %d bytes, which is more than %d: source_s3_bucket must be set", d.Get("source_dir").(string), len(content), functionZipFileMaxSize)
+	}
+
+	key := functionPackageS3Key(d.Get("source_s3_key_prefix").(string), d.Get("function_name").(string), content)
+
+	if err := uploadFunctionPackage(meta.(*conns.AWSClient).S3Conn, bucket.(string), key, content); err != nil {
+		return nil, err
+	}
+
+	return &lambda.FunctionCode{
+		S3Bucket: aws.String(bucket.(string)),
+		S3Key:    aws.String(key),
+	}, nil
+}
+
 func readEnvironmentVariables(ev map[string]interface{}) map[string]string {
 	variables := make(map[string]string)
 	for k, v := range ev {
@@ -0,0 +1,210 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// functionZipFileMaxSize is the maximum size of a deployment package uploaded directly to Lambda.
+	functionZipFileMaxSize = 50 * 1024 * 1024
+)
+
+// functionPackageModified is the modification time of every entry of a package.
+// It's the earliest time that a zip archive can represent.
+var functionPackageModified = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// buildFunctionPackage returns a zip archive of the files in the source directory.
+// The archive is reproducible: entries are sorted by path, have the same modification time
+// and have mode 0755 if the file is executable by anyone and 0644 otherwise.
+// If includes is not empty, only files that match one of its globs are archived.
+// Files and directories that match one of the excludes globs are skipped.
+// Globs are matched against slash-separated paths relative to the source directory and
+// "**" matches any number of directories.
+func buildFunctionPackage(sourceDir string, includes, excludes []string) ([]byte, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	n := 0
+
+	// WalkDir visits entries in lexical order.
+	err = filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, filePath)
+
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		name := filepath.ToSlash(rel)
+
+		if functionPackageMatchAny(excludes, name) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		// Symbolic links are archived as the file they point to.
+		info, err := os.Stat(filePath)
+
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() || !info.Mode().IsRegular() {
+			return nil
+		}
+
+		if len(includes) > 0 && !functionPackageMatchAny(includes, name) {
+			return nil
+		}
+
+		header := &zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: functionPackageModified,
+		}
+
+		if info.Mode().Perm()&0111 != 0 {
+			header.SetMode(0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		fw, err := w.CreateHeader(header)
+
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(filePath)
+
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		if _, err := io.Copy(fw, f); err != nil {
+			return err
+		}
+
+		n++
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("archiving %s: %w", sourceDir, err)
+	}
+
+	if n == 0 {
+		return nil, fmt.Errorf("archiving %s: no files to archive", sourceDir)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("archiving %s: %w", sourceDir, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// functionPackageHash returns the base64-encoded SHA-256 hash of the package, as reported by Lambda.
+func functionPackageHash(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// functionPackageS3Key returns the S3 key of a package that is too large to upload directly to Lambda.
+func functionPackageS3Key(prefix, functionName string, content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return fmt.Sprintf("%s%s/%s.zip", prefix, functionName, hex.EncodeToString(hash[:]))
+}
+
+func uploadFunctionPackage(conn *s3.S3, bucket, key string, content []byte) error {
+	_, err := conn.PutObject(&s3.PutObjectInput{
+		Body:   bytes.NewReader(content),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return fmt.Errorf("uploading Lambda deployment package to S3 (%s/%s): %w", bucket, key, err)
+	}
+
+	return nil
+}
+
+func functionPackageMatchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if functionPackageMatch(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// functionPackageMatch returns whether the slash-separated path matches the glob.
+// "**" matches zero or more path elements, other elements are matched using path.Match.
+func functionPackageMatch(pattern, name string) bool {
+	return functionPackageMatchElems(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+func functionPackageMatchElems(patterns, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := 0; i <= len(names); i++ {
+				if functionPackageMatchElems(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(names) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(patterns[0], names[0]); !ok {
+			return false
+		}
+
+		patterns = patterns[1:]
+		names = names[1:]
+	}
+
+	return len(names) == 0
+}
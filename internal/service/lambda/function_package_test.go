@@ -0,0 +1,123 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFunctionPackageMatch(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"*.js", "index.js", true},
+		{"*.js", "lib/index.js", false},
+		{"**/*.js", "index.js", true},
+		{"**/*.js", "lib/util/index.js", true},
+		{"lib/**", "lib/util/index.js", true},
+		{"lib/**", "src/index.js", false},
+		{"**/node_modules", "a/node_modules", true},
+		{"**/node_modules", "a/node_modules/b", false},
+		{"test", "test", true},
+		{"test/", "test", true},
+	}
+
+	for _, tc := range cases {
+		if got := functionPackageMatch(tc.pattern, tc.name); got != tc.match {
+			t.Errorf("functionPackageMatch(%q, %q) = %t, want %t", tc.pattern, tc.name, got, tc.match)
+		}
+	}
+}
+
+func TestBuildFunctionPackage(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, mode := range map[string]os.FileMode{
+		"index.js":                0600,
+		"bootstrap":               0700,
+		"lib/util.js":             0644,
+		"test/index_test.js":      0644,
+		"node_modules/a/index.js": 0644,
+		"README.md":               0644,
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	includes := []string{"bootstrap", "**/*.js"}
+	excludes := []string{"test"}
+
+	content, err := buildFunctionPackage(dir, includes, excludes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+	modes := make(map[string]os.FileMode)
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+		modes[f.Name] = f.Mode().Perm()
+
+		if !f.Modified.Equal(functionPackageModified) {
+			t.Errorf("%s: got modification time %s, want %s", f.Name, f.Modified, functionPackageModified)
+		}
+	}
+
+	if want := []string{"bootstrap", "index.js", "lib/util.js", "node_modules/a/index.js"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got entries %v, want %v", names, want)
+	}
+
+	if got, want := modes["bootstrap"], os.FileMode(0755); got != want {
+		t.Errorf("bootstrap: got mode %s, want %s", got, want)
+	}
+
+	if got, want := modes["index.js"], os.FileMode(0644); got != want {
+		t.Errorf("index.js: got mode %s, want %s", got, want)
+	}
+
+	// Changing timestamps and non-executable permissions doesn't change the package.
+	later := time.Now().Add(time.Hour)
+
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(filepath.Join(dir, "index.js"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := buildFunctionPackage(dir, includes, excludes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := functionPackageHash(again), functionPackageHash(content); got != want {
+		t.Errorf("got hash %s, want %s", got, want)
+	}
+
+	if _, err := buildFunctionPackage(dir, []string{"*.py"}, nil); err == nil {
+		t.Error("expected error for empty package, got none")
+	}
+}
@@ -76,6 +76,47 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	resourceName := "aws_lambda_function.test"
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_dir_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_source_dir_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_dir_%s", rString)
+	sgName := fmt.Sprintf("tf_acc_sg_lambda_func_source_dir_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceDirConfig(funcName, policyName, roleName, sgName, "lambda_func.js"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckFunctionName(&conf, funcName),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				// The package is rebuilt identically.
+				Config:   testAccSourceDirConfig(funcName, policyName, roleName, sgName, "lambda_func.js"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccSourceDirConfig(funcName, policyName, roleName, sgName, "lambda_func_modified.js"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -2404,6 +2445,20 @@ resource "aws_lambda_function" "test" {
 `, funcName)
 }
 
+func testAccSourceDirConfig(funcName, policyName, roleName, sgName, include string) string {
+	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
+resource "aws_lambda_function" "test" {
+  source_dir      = "test-fixtures"
+  source_includes = [%[2]q]
+  function_name   = %[1]q
+  role            = aws_iam_role.iam_for_lambda.arn
+  handler         = "lambda_func.handler"
+  runtime         = "nodejs12.x"
+  publish         = true
+}
+`, funcName, include)
+}
+
 func testAccCSCBasicConfig(roleName string) string {
 	return fmt.Sprintf(`
 data "aws_iam_policy_document" "policy" {
@@ -50,6 +50,7 @@ func ResourceInvocation() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceInvocationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LambdaConn
 
@@ -385,6 +385,7 @@ func resourceIntentRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("last_updated_date", resp.LastUpdatedDate.Format(time.RFC3339))
 	d.Set("name", resp.Name)
 
+	//lintignore:AWSR003
 	version, err := FindLatestIntentVersionByName(conn, d.Id())
 
 	if err != nil {
@@ -0,0 +1,943 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FilterPattern is a compiled CloudWatch Logs filter pattern, as used by metric filters and
+// subscription filters. There are three kinds of filter pattern:
+//  1. Terms, e.g. `ERROR ?WARN -Retry "Failed to"`, which match unstructured log events.
+//  2. JSON, e.g. `{ $.eventType = "UpdateTrail" && $.latency > 100 }`, which match JSON log events.
+//  3. Space-delimited, e.g. `[ip, user, ..., status_code = 4*, bytes > 1000]`, which match log
+//     events with fields separated by spaces.
+//
+// The empty pattern matches every log event.
+//
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/FilterAndPatternSyntax.html.
+type FilterPattern struct {
+	match func(event string) bool
+}
+
+// Match returns whether the log event message matches the filter pattern.
+func (p *FilterPattern) Match(event string) bool {
+	return p.match(event)
+}
+
+// CompileFilterPattern parses a filter pattern. Errors include the position of the problem in
+// the pattern.
+func CompileFilterPattern(pattern string) (*FilterPattern, error) {
+	start := strings.IndexFunc(pattern, func(r rune) bool { return !strings.ContainsRune(filterPatternSpaces, r) })
+
+	if start == -1 {
+		return &FilterPattern{match: func(string) bool { return true }}, nil
+	}
+
+	var match func(string) bool
+	var err error
+
+	switch pattern[start] {
+	case '{':
+		match, err = compileJSONFilterPattern(pattern)
+	case '[':
+		match, err = compileSpaceDelimitedFilterPattern(pattern)
+	default:
+		match, err = compileTermsFilterPattern(pattern)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilterPattern{match: match}, nil
+}
+
+type filterPatternError struct {
+	pos     int
+	message string
+}
+
+func (e *filterPatternError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.message, e.pos+1)
+}
+
+// filterPatternSpaces are the characters that separate terms. Only ASCII whitespace is used, so that
+// patterns and events can be scanned byte by byte without splitting multi-byte UTF-8 characters.
+const filterPatternSpaces = " \t\n\r"
+
+func isFilterPatternSpace(c byte) bool {
+	return strings.IndexByte(filterPatternSpaces, c) != -1
+}
+
+func filterPatternErrorf(pos int, format string, a ...interface{}) error {
+	return &filterPatternError{pos: pos, message: fmt.Sprintf(format, a...)}
+}
+
+type filterPatternTerm struct {
+	text  string
+	regex *regexp.Regexp
+}
+
+func (t filterPatternTerm) in(event string) bool {
+	if t.regex != nil {
+		return t.regex.MatchString(event)
+	}
+
+	return strings.Contains(event, t.text)
+}
+
+func compileTermsFilterPattern(pattern string) (func(string) bool, error) {
+	var required, optional, excluded []filterPatternTerm
+
+	for i := 0; i < len(pattern); {
+		if isFilterPatternSpace(pattern[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		terms := &required
+
+		switch pattern[i] {
+		case '?':
+			terms = &optional
+			i++
+		case '-':
+			terms = &excluded
+			i++
+		}
+
+		if i == len(pattern) || isFilterPatternSpace(pattern[i]) {
+			return nil, filterPatternErrorf(start, "expected a term after %q", pattern[start:i])
+		}
+
+		var term filterPatternTerm
+
+		switch pattern[i] {
+		case '"':
+			text, end, err := readFilterPatternString(pattern, i)
+
+			if err != nil {
+				return nil, err
+			}
+
+			term.text, i = text, end
+		case '%':
+			regex, end, err := readFilterPatternRegex(pattern, i)
+
+			if err != nil {
+				return nil, err
+			}
+
+			term.regex, i = regex, end
+		default:
+			end := i
+
+			for end < len(pattern) && !isFilterPatternSpace(pattern[end]) {
+				if pattern[end] == '"' {
+					return nil, filterPatternErrorf(end, "unexpected quote in term, quote the whole term")
+				}
+
+				end++
+			}
+
+			term.text, i = pattern[i:end], end
+		}
+
+		if i < len(pattern) && !isFilterPatternSpace(pattern[i]) {
+			return nil, filterPatternErrorf(i, "expected a space after term")
+		}
+
+		*terms = append(*terms, term)
+	}
+
+	return func(event string) bool {
+		for _, term := range required {
+			if !term.in(event) {
+				return false
+			}
+		}
+
+		for _, term := range excluded {
+			if term.in(event) {
+				return false
+			}
+		}
+
+		if len(optional) == 0 {
+			return true
+		}
+
+		for _, term := range optional {
+			if term.in(event) {
+				return true
+			}
+		}
+
+		return false
+	}, nil
+}
+
+// readFilterPatternString reads the double-quoted string starting at pattern[start], returning
+// its unescaped text and the position after the closing quote.
+func readFilterPatternString(pattern string, start int) (string, int, error) {
+	var b strings.Builder
+
+	for i := start + 1; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+
+			b.WriteByte(pattern[i])
+		case '"':
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(pattern[i])
+		}
+	}
+
+	return "", 0, filterPatternErrorf(start, "unterminated quoted string")
+}
+
+// readFilterPatternRegex reads the regular expression between percent signs starting at
+// pattern[start], returning it and the position after the closing percent sign.
+func readFilterPatternRegex(pattern string, start int) (*regexp.Regexp, int, error) {
+	end := strings.IndexByte(pattern[start+1:], '%')
+
+	if end == -1 {
+		return nil, 0, filterPatternErrorf(start, "unterminated regular expression")
+	}
+
+	end += start + 1
+
+	if end == start+1 {
+		return nil, 0, filterPatternErrorf(start, "empty regular expression")
+	}
+
+	regex, err := regexp.Compile(pattern[start+1 : end])
+
+	if err != nil {
+		return nil, 0, filterPatternErrorf(start, "invalid regular expression: %s", err)
+	}
+
+	return regex, end + 1, nil
+}
+
+type filterPatternTokenKind int
+
+const (
+	filterPatternTokenEOF filterPatternTokenKind = iota
+	filterPatternTokenWord
+	filterPatternTokenString
+	filterPatternTokenRegex
+	filterPatternTokenPunct
+	filterPatternTokenOperator
+)
+
+type filterPatternToken struct {
+	kind  filterPatternTokenKind
+	pos   int
+	regex *regexp.Regexp
+	text  string
+}
+
+func (t filterPatternToken) String() string {
+	switch t.kind {
+	case filterPatternTokenEOF:
+		return "end of pattern"
+	case filterPatternTokenString:
+		return strconv.Quote(t.text)
+	case filterPatternTokenRegex:
+		return fmt.Sprintf("%%%s%%", t.text)
+	}
+
+	return fmt.Sprintf("%q", t.text)
+}
+
+func (t filterPatternToken) is(text string) bool {
+	return (t.kind == filterPatternTokenPunct || t.kind == filterPatternTokenOperator) && t.text == text
+}
+
+// isKeyword returns whether the token is the unquoted word keyword, ignoring case.
+func (t filterPatternToken) isKeyword(keyword string) bool {
+	return t.kind == filterPatternTokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func lexFilterPattern(pattern string) ([]filterPatternToken, error) {
+	var tokens []filterPatternToken
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+
+		switch {
+		case isFilterPatternSpace(c):
+			i++
+		case strings.IndexByte("{}[](),", c) != -1:
+			tokens = append(tokens, filterPatternToken{kind: filterPatternTokenPunct, pos: i, text: string(c)})
+			i++
+		case c == '=':
+			tokens = append(tokens, filterPatternToken{kind: filterPatternTokenOperator, pos: i, text: "="})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			if i+1 < len(pattern) && pattern[i+1] == '=' {
+				tokens = append(tokens, filterPatternToken{kind: filterPatternTokenOperator, pos: i, text: pattern[i : i+2]})
+				i += 2
+			} else if c != '!' {
+				tokens = append(tokens, filterPatternToken{kind: filterPatternTokenOperator, pos: i, text: string(c)})
+				i++
+			} else {
+				return nil, filterPatternErrorf(i, "unexpected %q", string(c))
+			}
+		case c == '&' || c == '|':
+			if i+1 >= len(pattern) || pattern[i+1] != c {
+				return nil, filterPatternErrorf(i, "unexpected %q, expected %q", string(c), strings.Repeat(string(c), 2))
+			}
+
+			tokens = append(tokens, filterPatternToken{kind: filterPatternTokenOperator, pos: i, text: pattern[i : i+2]})
+			i += 2
+		case c == '"':
+			text, end, err := readFilterPatternString(pattern, i)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, filterPatternToken{kind: filterPatternTokenString, pos: i, text: text})
+			i = end
+		case c == '%':
+			regex, end, err := readFilterPatternRegex(pattern, i)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, filterPatternToken{kind: filterPatternTokenRegex, pos: i, regex: regex, text: pattern[i+1 : end-1]})
+			i = end
+		default:
+			// JSON selectors may contain brackets, e.g. $.array[0].
+			selector := c == '$'
+			end := i
+
+			for end < len(pattern) {
+				c := pattern[end]
+
+				if isFilterPatternSpace(c) || strings.IndexByte("{}(),=!<>&|\"%", c) != -1 || (!selector && (c == '[' || c == ']')) {
+					break
+				}
+
+				end++
+			}
+
+			tokens = append(tokens, filterPatternToken{kind: filterPatternTokenWord, pos: i, text: pattern[i:end]})
+			i = end
+		}
+	}
+
+	return append(tokens, filterPatternToken{kind: filterPatternTokenEOF, pos: len(pattern)}), nil
+}
+
+type filterPatternParser struct {
+	tokens []filterPatternToken
+	i      int
+}
+
+func (p *filterPatternParser) peek() filterPatternToken {
+	return p.tokens[p.i]
+}
+
+func (p *filterPatternParser) next() filterPatternToken {
+	t := p.tokens[p.i]
+
+	if t.kind != filterPatternTokenEOF {
+		p.i++
+	}
+
+	return t
+}
+
+func (p *filterPatternParser) expect(text string) error {
+	if t := p.next(); !t.is(text) {
+		return filterPatternErrorf(t.pos, "expected %q, got %s", text, t)
+	}
+
+	return nil
+}
+
+// parseOr parses comparisons joined by && and ||, where && binds more tightly, and parenthesized
+// groups of them. primary parses a single comparison.
+func (p *filterPatternParser) parseOr(primary func() (func(interface{}) bool, error)) (func(interface{}) bool, error) {
+	var alternatives []func(interface{}) bool
+
+	for {
+		var conjuncts []func(interface{}) bool
+
+		for {
+			var operand func(interface{}) bool
+			var err error
+
+			if p.peek().is("(") {
+				p.next()
+
+				if operand, err = p.parseOr(primary); err != nil {
+					return nil, err
+				}
+
+				if err := p.expect(")"); err != nil {
+					return nil, err
+				}
+			} else if operand, err = primary(); err != nil {
+				return nil, err
+			}
+
+			conjuncts = append(conjuncts, operand)
+
+			if !p.peek().is("&&") {
+				break
+			}
+
+			p.next()
+		}
+
+		alternatives = append(alternatives, func(v interface{}) bool {
+			for _, f := range conjuncts {
+				if !f(v) {
+					return false
+				}
+			}
+
+			return true
+		})
+
+		if !p.peek().is("||") {
+			break
+		}
+
+		p.next()
+	}
+
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+
+	return func(v interface{}) bool {
+		for _, f := range alternatives {
+			if f(v) {
+				return true
+			}
+		}
+
+		return false
+	}, nil
+}
+
+// filterPatternValue is the right-hand side of a comparison.
+type filterPatternValue struct {
+	glob   *regexp.Regexp
+	number *float64
+	regex  *regexp.Regexp
+	text   string
+}
+
+// parseComparison parses a comparison operator and value.
+func (p *filterPatternParser) parseComparison() (string, filterPatternValue, error) {
+	op := p.next()
+
+	if op.kind != filterPatternTokenOperator || op.is("&&") || op.is("||") {
+		return "", filterPatternValue{}, filterPatternErrorf(op.pos, "expected a comparison operator, got %s", op)
+	}
+
+	t := p.next()
+	var value filterPatternValue
+
+	switch t.kind {
+	case filterPatternTokenRegex:
+		if !op.is("=") && !op.is("!=") {
+			return "", value, filterPatternErrorf(t.pos, "regular expressions can only be compared with = or !=")
+		}
+
+		value.regex = t.regex
+	case filterPatternTokenString, filterPatternTokenWord:
+		value.text = t.text
+
+		if t.kind == filterPatternTokenWord {
+			if v, err := strconv.ParseFloat(t.text, 64); err == nil {
+				value.number = &v
+			}
+		}
+
+		if strings.Contains(t.text, "*") {
+			parts := strings.Split(t.text, "*")
+
+			for i, part := range parts {
+				parts[i] = regexp.QuoteMeta(part)
+			}
+
+			value.glob = regexp.MustCompile(`\A` + strings.Join(parts, ".*") + `\z`)
+		}
+	default:
+		return "", value, filterPatternErrorf(t.pos, "expected a value, got %s", t)
+	}
+
+	if !op.is("=") && !op.is("!=") && value.number == nil {
+		return "", value, filterPatternErrorf(t.pos, "%s requires a number, got %s", op.text, t)
+	}
+
+	return op.text, value, nil
+}
+
+// compare returns whether text, the string form of a field, satisfies the comparison. When the
+// field is a number, isNumber is true and number is its value.
+func (v filterPatternValue) compare(op string, text string, number float64, isNumber bool) bool {
+	switch op {
+	case "=", "!=":
+		var equal bool
+
+		switch {
+		case v.regex != nil:
+			equal = v.regex.MatchString(text)
+		case v.number != nil && isNumber:
+			equal = number == *v.number
+		case v.glob != nil:
+			equal = v.glob.MatchString(text)
+		default:
+			equal = text == v.text
+		}
+
+		return equal == (op == "=")
+	}
+
+	if !isNumber {
+		return false
+	}
+
+	switch op {
+	case "<":
+		return number < *v.number
+	case "<=":
+		return number <= *v.number
+	case ">":
+		return number > *v.number
+	case ">=":
+		return number >= *v.number
+	}
+
+	return false
+}
+
+type filterPatternSelectorSegment struct {
+	index    int
+	key      string
+	wildcard bool
+}
+
+func compileJSONFilterPattern(pattern string) (func(string) bool, error) {
+	tokens, err := lexFilterPattern(pattern)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterPatternParser{tokens: tokens}
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	condition, err := p.parseOr(p.parseJSONComparison)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+
+	if t := p.next(); t.kind != filterPatternTokenEOF {
+		return nil, filterPatternErrorf(t.pos, "unexpected %s after %q", t, "}")
+	}
+
+	return func(event string) bool {
+		decoder := json.NewDecoder(strings.NewReader(event))
+		decoder.UseNumber()
+
+		var document interface{}
+
+		if err := decoder.Decode(&document); err != nil {
+			return false
+		}
+
+		return condition(document)
+	}, nil
+}
+
+func (p *filterPatternParser) parseJSONComparison() (func(interface{}) bool, error) {
+	t := p.next()
+
+	if t.kind != filterPatternTokenWord || !strings.HasPrefix(t.text, "$") {
+		return nil, filterPatternErrorf(t.pos, "expected a selector starting with $, got %s", t)
+	}
+
+	selector, err := parseFilterPatternSelector(t)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch next := p.peek(); {
+	case next.isKeyword("IS"):
+		p.next()
+		keyword := p.next()
+
+		var want func(interface{}) bool
+
+		switch {
+		case keyword.isKeyword("NULL"):
+			want = func(v interface{}) bool { return v == nil }
+		case keyword.isKeyword("TRUE"):
+			want = func(v interface{}) bool { return v == true }
+		case keyword.isKeyword("FALSE"):
+			want = func(v interface{}) bool { return v == false }
+		default:
+			return nil, filterPatternErrorf(keyword.pos, "expected NULL, TRUE, or FALSE after IS, got %s", keyword)
+		}
+
+		return func(document interface{}) bool {
+			for _, v := range selectFilterPatternJSON(document, selector) {
+				if want(v) {
+					return true
+				}
+			}
+
+			return false
+		}, nil
+	case next.isKeyword("NOT"):
+		p.next()
+
+		if keyword := p.next(); !keyword.isKeyword("EXISTS") {
+			return nil, filterPatternErrorf(keyword.pos, "expected EXISTS after NOT, got %s", keyword)
+		}
+
+		return func(document interface{}) bool {
+			return len(selectFilterPatternJSON(document, selector)) == 0
+		}, nil
+	}
+
+	op, value, err := p.parseComparison()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(document interface{}) bool {
+		for _, v := range selectFilterPatternJSON(document, selector) {
+			var text string
+			var number float64
+			var isNumber bool
+
+			switch v := v.(type) {
+			case string:
+				text = v
+			case json.Number:
+				text = v.String()
+
+				if f, err := v.Float64(); err == nil {
+					number, isNumber = f, true
+				}
+			case bool:
+				text = strconv.FormatBool(v)
+			default:
+				continue
+			}
+
+			if value.compare(op, text, number, isNumber) {
+				return true
+			}
+		}
+
+		return false
+	}, nil
+}
+
+// parseFilterPatternSelector parses a JSON selector such as $.a.b[0].c, $.a[*], or $.a.*.
+func parseFilterPatternSelector(t filterPatternToken) ([]filterPatternSelectorSegment, error) {
+	var segments []filterPatternSelectorSegment
+	s := t.text
+
+	for i := 1; i < len(s); {
+		switch s[i] {
+		case '.':
+			end := i + 1
+
+			for end < len(s) && s[end] != '.' && s[end] != '[' {
+				end++
+			}
+
+			key := s[i+1 : end]
+
+			if key == "" {
+				return nil, filterPatternErrorf(t.pos+i, "expected a field name after %q in selector", ".")
+			}
+
+			segments = append(segments, filterPatternSelectorSegment{key: key, wildcard: key == "*"})
+			i = end
+		case '[':
+			end := strings.IndexByte(s[i:], ']')
+
+			if end == -1 {
+				return nil, filterPatternErrorf(t.pos+i, "unterminated %q in selector", "[")
+			}
+
+			end += i
+			index := s[i+1 : end]
+
+			if index == "*" {
+				segments = append(segments, filterPatternSelectorSegment{wildcard: true})
+			} else if n, err := strconv.Atoi(index); err == nil && n >= 0 {
+				segments = append(segments, filterPatternSelectorSegment{index: n})
+			} else {
+				return nil, filterPatternErrorf(t.pos+i, "expected an array index or * in selector, got %q", index)
+			}
+
+			i = end + 1
+		default:
+			return nil, filterPatternErrorf(t.pos+i, "expected %q or %q in selector, got %q", ".", "[", string(s[i]))
+		}
+	}
+
+	if len(segments) == 0 {
+		return nil, filterPatternErrorf(t.pos, "expected a field after %q in selector", "$")
+	}
+
+	return segments, nil
+}
+
+// selectFilterPatternJSON returns the values selected from the document. Wildcards can select
+// several values.
+func selectFilterPatternJSON(document interface{}, selector []filterPatternSelectorSegment) []interface{} {
+	values := []interface{}{document}
+
+	for _, segment := range selector {
+		var next []interface{}
+
+		for _, value := range values {
+			switch value := value.(type) {
+			case map[string]interface{}:
+				if segment.wildcard && segment.key == "*" {
+					for _, v := range value {
+						next = append(next, v)
+					}
+				} else if v, ok := value[segment.key]; ok && segment.key != "" {
+					next = append(next, v)
+				}
+			case []interface{}:
+				if segment.wildcard {
+					next = append(next, value...)
+				} else if segment.key == "" && segment.index < len(value) {
+					next = append(next, value[segment.index])
+				}
+			}
+		}
+
+		values = next
+	}
+
+	return values
+}
+
+type filterPatternSlot struct {
+	ellipsis  bool
+	condition func(interface{}) bool
+	name      string
+}
+
+func compileSpaceDelimitedFilterPattern(pattern string) (func(string) bool, error) {
+	tokens, err := lexFilterPattern(pattern)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterPatternParser{tokens: tokens}
+
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+
+	var slots []filterPatternSlot
+	names := make(map[string]bool)
+	var references []filterPatternToken
+
+	for !p.peek().is("]") {
+		if t := p.peek(); t.kind == filterPatternTokenEOF {
+			return nil, filterPatternErrorf(t.pos, "expected %q, got %s", "]", t)
+		}
+
+		if len(slots) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+
+		t := p.peek()
+
+		if t.kind == filterPatternTokenWord && t.text == "..." {
+			p.next()
+			slots = append(slots, filterPatternSlot{ellipsis: true})
+			continue
+		}
+
+		var slot filterPatternSlot
+		first := len(references)
+
+		if t.kind == filterPatternTokenWord && (p.tokens[p.i+1].is(",") || p.tokens[p.i+1].is("]") || p.tokens[p.i+1].kind == filterPatternTokenEOF) {
+			p.next()
+			references = append(references, t)
+		} else {
+			condition, err := p.parseOr(func() (func(interface{}) bool, error) {
+				t := p.next()
+
+				if t.kind != filterPatternTokenWord || t.text == "..." {
+					return nil, filterPatternErrorf(t.pos, "expected a field name, got %s", t)
+				}
+
+				references = append(references, t)
+
+				op, value, err := p.parseComparison()
+
+				if err != nil {
+					return nil, err
+				}
+
+				return func(fields interface{}) bool {
+					text := fields.(map[string]string)[t.text]
+					number, err := strconv.ParseFloat(text, 64)
+
+					return value.compare(op, text, number, err == nil)
+				}, nil
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			slot.condition = condition
+		}
+
+		// The slot's field is the first field its condition refers to.
+		name := references[first]
+
+		if names[name.text] {
+			return nil, filterPatternErrorf(name.pos, "duplicate field %q", name.text)
+		}
+
+		names[name.text] = true
+		slot.name = name.text
+		slots = append(slots, slot)
+	}
+
+	if err := p.expect("]"); err != nil {
+		return nil, err
+	}
+
+	if t := p.next(); t.kind != filterPatternTokenEOF {
+		return nil, filterPatternErrorf(t.pos, "unexpected %s after %q", t, "]")
+	}
+
+	for _, reference := range references {
+		if !names[reference.text] {
+			return nil, filterPatternErrorf(reference.pos, "unknown field %q", reference.text)
+		}
+	}
+
+	return func(event string) bool {
+		values := make(map[string]string, len(names))
+
+		return matchFilterPatternSlots(slots, splitFilterPatternFields(event), values, func() bool {
+			for _, slot := range slots {
+				if slot.condition != nil && !slot.condition(values) {
+					return false
+				}
+			}
+
+			return true
+		})
+	}, nil
+}
+
+// matchFilterPatternSlots returns whether the fields can be assigned to the slots, each ellipsis
+// taking any number of fields, so that check returns true.
+func matchFilterPatternSlots(slots []filterPatternSlot, fields []string, values map[string]string, check func() bool) bool {
+	if len(slots) == 0 {
+		return len(fields) == 0 && check()
+	}
+
+	if slots[0].ellipsis {
+		for n := 0; n <= len(fields); n++ {
+			if matchFilterPatternSlots(slots[1:], fields[n:], values, check) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(fields) == 0 {
+		return false
+	}
+
+	values[slots[0].name] = fields[0]
+
+	return matchFilterPatternSlots(slots[1:], fields[1:], values, check)
+}
+
+// splitFilterPatternFields splits a log event into fields separated by spaces. Text enclosed in
+// double quotes or square brackets is a single field, without the enclosing characters.
+func splitFilterPatternFields(event string) []string {
+	var fields []string
+
+	for i := 0; i < len(event); {
+		if isFilterPatternSpace(event[i]) {
+			i++
+			continue
+		}
+
+		var closing byte
+
+		switch event[i] {
+		case '"':
+			closing = '"'
+		case '[':
+			closing = ']'
+		}
+
+		if closing != 0 {
+			end := strings.IndexByte(event[i+1:], closing)
+
+			if end == -1 {
+				fields = append(fields, event[i+1:])
+				break
+			}
+
+			fields = append(fields, event[i+1:i+1+end])
+			i += end + 2
+			continue
+		}
+
+		end := i
+
+		for end < len(event) && !isFilterPatternSpace(event[end]) {
+			end++
+		}
+
+		fields = append(fields, event[i:end])
+		i = end
+	}
+
+	return fields
+}
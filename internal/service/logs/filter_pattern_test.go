@@ -0,0 +1,140 @@
+package logs
+
+import (
+	"testing"
+)
+
+func TestFilterPatternMatch(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Pattern string
+		Event   string
+		Match   bool
+	}{
+		{"empty", "", "anything", true},
+		{"blank", "  \n", "anything", true},
+
+		{"term", "ERROR", "[ERROR] Caught IllegalArgumentException", true},
+		{"term case sensitive", "error", "[ERROR] Caught IllegalArgumentException", false},
+		{"terms", "ERROR Exception", "[ERROR] Caught IllegalArgumentException", true},
+		{"terms missing", "ERROR Timeout", "[ERROR] Caught IllegalArgumentException", false},
+		{"quoted term", `"Caught Illegal"`, "[ERROR] Caught IllegalArgumentException", true},
+		{"quoted term escape", `"say \"hi\""`, `they say "hi"`, true},
+		{"optional terms", "?ERROR ?WARN", "[WARN] Low disk", true},
+		{"optional terms missing", "?ERROR ?WARN", "[INFO] Started", false},
+		{"excluded term", "ERROR -Retry", "[ERROR] Retry in 5s", false},
+		{"excluded term absent", "ERROR -Retry", "[ERROR] Failed", true},
+		{"regex term", "%ERR(OR)?%", "[ERR] Failed", true},
+		{"regex term no match", "%^ERROR%", "[ERROR] Failed", false},
+		{"non-ASCII term", "voilà", "et voilà!", true},
+		{"non-ASCII terms", "хорошо да", "хорошо нет да", true},
+		{"non-ASCII excluded term", "хорошо -нет", "хорошо нет да", false},
+
+		{"json string", `{ $.eventType = "UpdateTrail" }`, `{"eventType": "UpdateTrail"}`, true},
+		{"json string no match", `{ $.eventType = "UpdateTrail" }`, `{"eventType": "DeleteTrail"}`, false},
+		{"json unquoted", `{ $.eventType = UpdateTrail }`, `{"eventType": "UpdateTrail"}`, true},
+		{"json wildcard", `{ $.eventType = "Update*" }`, `{"eventType": "UpdateTrail"}`, true},
+		{"json not equal", `{ $.eventType != "UpdateTrail" }`, `{"eventType": "DeleteTrail"}`, true},
+		{"json not equal missing", `{ $.eventType != "UpdateTrail" }`, `{}`, false},
+		{"json regex", `{ $.message = %time(d out|out)% }`, `{"message": "request timed out"}`, true},
+		{"json nested", `{ $.user.id = 42 }`, `{"user": {"id": 42}}`, true},
+		{"json number string", `{ $.code = 200 }`, `{"code": "200"}`, true},
+		{"json number float", `{ $.latency = 1.0 }`, `{"latency": 1}`, true},
+		{"json numeric", `{ $.latency > 100 }`, `{"latency": 250}`, true},
+		{"json numeric no match", `{ $.latency <= 100 }`, `{"latency": 250}`, false},
+		{"json numeric string", `{ $.latency > 100 }`, `{"latency": "250"}`, false},
+		{"json array index", `{ $.items[1].name = "b" }`, `{"items": [{"name": "a"}, {"name": "b"}]}`, true},
+		{"json array wildcard", `{ $.items[*].name = "b" }`, `{"items": [{"name": "a"}, {"name": "b"}]}`, true},
+		{"json object wildcard", `{ $.tags.* = "prod" }`, `{"tags": {"env": "prod"}}`, true},
+		{"json is null", `{ $.error IS NULL }`, `{"error": null}`, true},
+		{"json is null missing", `{ $.error IS NULL }`, `{}`, false},
+		{"json not exists", `{ $.error NOT EXISTS }`, `{}`, true},
+		{"json not exists present", `{ $.error NOT EXISTS }`, `{"error": null}`, false},
+		{"json is true", `{ $.enabled IS TRUE }`, `{"enabled": true}`, true},
+		{"json is false", `{ $.enabled is false }`, `{"enabled": true}`, false},
+		{"json and", `{ $.a = 1 && $.b = 2 }`, `{"a": 1, "b": 2}`, true},
+		{"json and no match", `{ $.a = 1 && $.b = 3 }`, `{"a": 1, "b": 2}`, false},
+		{"json or", `{ $.a = 3 || $.b = 2 }`, `{"a": 1, "b": 2}`, true},
+		{"json precedence", `{ $.a = 1 || $.a = 2 && $.b = 3 }`, `{"a": 1, "b": 2}`, true},
+		{"json parentheses", `{ ($.a = 1 || $.a = 2) && $.b = 3 }`, `{"a": 1, "b": 2}`, false},
+		{"json non-ASCII unquoted", `{ $.city = voilà }`, `{"city":"voilà"}`, true},
+		{"json non-ASCII", `{ $.city = "Zürich" }`, `{"city": "Zürich"}`, true},
+		{"json not json", `{ $.a = 1 }`, `a = 1`, false},
+
+		{"space-delimited", `[ip, user, username, timestamp, request, status_code, bytes]`, `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 200 1534`, true},
+		{"space-delimited count", `[ip, user, username, timestamp, request, status_code]`, `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 200 1534`, false},
+		{"space-delimited condition", `[ip, user, username, timestamp, request = "GET*", status_code = 2*, bytes > 1000]`, `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 200 1534`, true},
+		{"space-delimited condition no match", `[ip, user, username, timestamp, request, status_code = 4*, bytes]`, `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 200 1534`, false},
+		{"space-delimited numeric equal", `[ip, user, username, timestamp, request, status_code = 200, bytes]`, `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 200.0 1534`, true},
+		{"space-delimited brackets", `[ip, user, username, timestamp = "10/Oct/2000*", ...]`, `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 200 1534`, true},
+		{"space-delimited ellipsis", `[..., status_code = 200, bytes]`, `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 200 1534`, true},
+		{"space-delimited ellipses", `[..., request = *gif*, ...]`, `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 200 1534`, true},
+		{"space-delimited or", `[..., status_code = 4* || status_code = 5*, bytes]`, `127.0.0.1 - - [10/Oct/2000:13:25:15 -0700] "GET / HTTP/1.0" 503 0`, true},
+		{"space-delimited other field", `[..., status_code, bytes = 0 && status_code != 200]`, `127.0.0.1 - - [10/Oct/2000:13:25:15 -0700] "GET / HTTP/1.0" 503 0`, true},
+		{"space-delimited regex", `[level = %^(WARN|ERROR)$%, ...]`, `WARN disk almost full`, true},
+		{"space-delimited numeric not number", `[level, count > 1]`, `WARN many`, false},
+		{"space-delimited non-ASCII", `[a, b, c]`, `хорошо нет да`, true},
+		{"space-delimited non-ASCII condition", `[a, b = "нет", c]`, `хорошо нет да`, true},
+		{"space-delimited non-breaking space", `[a, b]`, "à\u00a0b c", true},
+		{"space-delimited empty", `[]`, ``, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			pattern, err := CompileFilterPattern(testCase.Pattern)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := pattern.Match(testCase.Event); got != testCase.Match {
+				t.Errorf("got %t, expected %t", got, testCase.Match)
+			}
+		})
+	}
+}
+
+func TestCompileFilterPattern(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Pattern string
+		Error   string
+	}{
+		{"unterminated quote", `ERROR "Failed to`, `unterminated quoted string at position 7`},
+		{"quote in term", `ERROR Fail"ed`, `unexpected quote in term, quote the whole term at position 11`},
+		{"empty optional term", `ERROR ? WARN`, `expected a term after "?" at position 7`},
+		{"invalid regex", `%ERR(%`, "invalid regular expression: error parsing regexp: missing closing ): `ERR(` at position 1"},
+		{"unterminated regex", `%ERR`, `unterminated regular expression at position 1`},
+		{"json unterminated", `{ $.a = 1`, `expected "}", got end of pattern at position 10`},
+		{"json selector", `{ a = 1 }`, `expected a selector starting with $, got "a" at position 3`},
+		{"json empty selector", `{ $ = 1 }`, `expected a field after "$" in selector at position 3`},
+		{"json selector index", `{ $.a[x] = 1 }`, `expected an array index or * in selector, got "x" at position 6`},
+		{"json operator", `{ $.a == 1 }`, `expected a value, got "=" at position 8`},
+		{"json single and", `{ $.a = 1 & $.b = 2 }`, `unexpected "&", expected "&&" at position 11`},
+		{"json numeric string", `{ $.a > "x" }`, `> requires a number, got "x" at position 9`},
+		{"json regex operator", `{ $.a > %x% }`, `regular expressions can only be compared with = or != at position 9`},
+		{"json is", `{ $.a IS EMPTY }`, `expected NULL, TRUE, or FALSE after IS, got "EMPTY" at position 10`},
+		{"json not", `{ $.a NOT NULL }`, `expected EXISTS after NOT, got "NULL" at position 11`},
+		{"json trailing", `{ $.a = 1 } ERROR`, `unexpected "ERROR" after "}" at position 13`},
+		{"json parenthesis", `{ ($.a = 1 }`, `expected ")", got "}" at position 12`},
+		{"space-delimited separator", `[a b]`, `expected a comparison operator, got "b" at position 4`},
+		{"space-delimited duplicate", `[a, a]`, `duplicate field "a" at position 5`},
+		{"space-delimited unknown", `[a, b = 1 && c = 2]`, `unknown field "c" at position 14`},
+		{"space-delimited unterminated", `[a, b`, `expected "]", got end of pattern at position 6`},
+		{"space-delimited numeric", `[a > b]`, `> requires a number, got "b" at position 6`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := CompileFilterPattern(testCase.Pattern)
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got := err.Error(); got != testCase.Error {
+				t.Errorf("got error %q, expected %q", got, testCase.Error)
+			}
+		})
+	}
+}
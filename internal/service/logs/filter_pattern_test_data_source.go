@@ -0,0 +1,81 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourceFilterPatternTest() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFilterPatternTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"any_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"log_event_messages": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"matches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validFilterPattern,
+			},
+		},
+	}
+}
+
+func dataSourceFilterPatternTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filterPattern := d.Get("pattern").(string)
+
+	pattern, err := CompileFilterPattern(filterPattern)
+
+	if err != nil {
+		return diag.Errorf("invalid filter pattern: %s", err)
+	}
+
+	messages := d.Get("log_event_messages").([]interface{})
+	matches := make([]bool, 0, len(messages))
+	allMatch, anyMatch := true, false
+	id := []string{filterPattern}
+
+	for _, message := range messages {
+		message, _ := message.(string)
+
+		match := pattern.Match(message)
+
+		matches = append(matches, match)
+		allMatch = allMatch && match
+		anyMatch = anyMatch || match
+		id = append(id, message)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join(id, "\n"))))
+	d.Set("all_match", allMatch)
+	d.Set("any_match", anyMatch)
+
+	if err := d.Set("matches", matches); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting matches: %w", err))
+	}
+
+	return nil
+}
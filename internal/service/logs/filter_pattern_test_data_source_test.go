@@ -0,0 +1,73 @@
+package logs_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLogsFilterPatternTestDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_log_filter_pattern_test.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterPatternTestDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_match", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "any_match", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.0", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.1", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.2", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsFilterPatternTestDataSource_invalidPattern(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFilterPatternTestDataSourceConfig_invalidPattern,
+				ExpectError: regexp.MustCompile(`isn't a valid filter pattern: > requires a number`),
+			},
+		},
+	})
+}
+
+const testAccFilterPatternTestDataSourceConfig_basic = `
+data "aws_cloudwatch_log_filter_pattern_test" "test" {
+  pattern = "{ $.level = \"ERROR\" && $.latency > 100 }"
+
+  log_event_messages = [
+    jsonencode({
+      level   = "ERROR"
+      latency = 250
+    }),
+    jsonencode({
+      level   = "ERROR"
+      latency = 50
+    }),
+    "ERROR latency 250",
+  ]
+}
+`
+
+const testAccFilterPatternTestDataSourceConfig_invalidPattern = `
+data "aws_cloudwatch_log_filter_pattern_test" "test" {
+  pattern = "{ $.latency > \"slow\" }"
+
+  log_event_messages = ["{}"]
+}
+`
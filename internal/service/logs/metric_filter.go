@@ -37,9 +37,12 @@ func ResourceMetricFilter() *schema.Resource {
 			},
 
 			"pattern": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024),
+					validFilterPatternAsWarning,
+				),
 				StateFunc: func(v interface{}) string {
 					s, ok := v.(string)
 					if !ok {
@@ -88,6 +88,7 @@ func getQueryDefinitionInput(d *schema.ResourceData) *cloudwatchlogs.PutQueryDef
 func resourceQueryDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LogsConn
 
+	//lintignore:AWSR003
 	result, err := FindQueryDefinition(ctx, conn, d.Get("name").(string), d.Id())
 
 	if err != nil {
@@ -43,9 +43,12 @@ func ResourceSubscriptionFilter() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"filter_pattern": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024),
+					validFilterPatternAsWarning,
+				),
 			},
 			"log_group_name": {
 				Type:     schema.TypeString,
@@ -68,6 +71,7 @@ func ResourceSubscriptionFilter() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSubscriptionFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LogsConn
 	params := getSubscriptionFilterInput(d)
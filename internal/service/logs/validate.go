@@ -97,3 +97,21 @@ func validLogMetricFilterTransformationName(v interface{}, k string) (ws []strin
 
 	return
 }
+
+func validFilterPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := CompileFilterPattern(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q isn't a valid filter pattern: %w", k, err))
+	}
+
+	return
+}
+
+// validFilterPatternAsWarning reports filter patterns that can't be parsed locally as warnings.
+// CloudWatch Logs accepts syntax that isn't modeled locally, so the API remains the authority.
+func validFilterPatternAsWarning(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := CompileFilterPattern(v.(string)); err != nil {
+		ws = append(ws, fmt.Sprintf("%q may not be a valid filter pattern: %s", k, err))
+	}
+
+	return
+}
@@ -136,3 +136,28 @@ func TestValidLogMetricTransformationName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFilterPatternAsWarning(t *testing.T) {
+	validPatterns := []string{
+		"",
+		"ERROR ?WARN",
+		`{ $.eventType = "UpdateTrail" }`,
+	}
+	for _, v := range validPatterns {
+		ws, errors := validFilterPatternAsWarning(v, "pattern")
+		if len(ws) != 0 || len(errors) != 0 {
+			t.Fatalf("%q should be a valid Filter Pattern: %q %q", v, ws, errors)
+		}
+	}
+
+	unsupportedPatterns := []string{
+		`{ $.['a b'] = 1 }`,
+		`a"b`,
+	}
+	for _, v := range unsupportedPatterns {
+		ws, errors := validFilterPatternAsWarning(v, "pattern")
+		if len(ws) == 0 || len(errors) != 0 {
+			t.Fatalf("%q should only warn: %q %q", v, ws, errors)
+		}
+	}
+}
@@ -323,6 +323,7 @@ func ResourceClassificationJob() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSR006
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"job_id": {
@@ -86,6 +86,7 @@ func ResourceCustomDataIdentifier() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(1, 300),
 			},
+			//lintignore:AWSR006
 			"tags":     tftags.TagsSchemaForceNew(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"created_at": {
@@ -117,6 +117,7 @@ func ResourceFindingsFilter() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSR006
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"arn": {
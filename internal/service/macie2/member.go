@@ -38,6 +38,7 @@ func ResourceMember() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSR006
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"arn": {
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
 		Name: "aws_mwaa_environment",
 		F:    sweepEnvironment,
 	})
@@ -43,7 +43,7 @@ func sweepEnvironment(region string) error {
 		d := r.Data(nil)
 		d.SetId(name)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete MWAA Environment %s: %s", name, err)
 		}
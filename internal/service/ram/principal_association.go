@@ -91,6 +91,7 @@ func resourcePrincipalAssociationRead(d *schema.ResourceData, meta interface{})
 
 	if ok, _ := regexp.MatchString(`^\d{12}$`, principal); ok {
 		// AWS Account ID Principals need to be accepted to become ASSOCIATED
+		//lintignore:AWSR003
 		association, err = FindResourceSharePrincipalAssociationByShareARNPrincipal(conn, resourceShareArn, principal)
 	} else {
 		association, err = WaitResourceSharePrincipalAssociated(conn, resourceShareArn, principal)
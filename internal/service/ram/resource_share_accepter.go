@@ -129,6 +129,7 @@ func resourceResourceShareAccepterRead(d *schema.ResourceData, meta interface{})
 	accountID := meta.(*conns.AWSClient).AccountID
 	conn := meta.(*conns.AWSClient).RAMConn
 
+	//lintignore:AWSR003
 	invitation, err := FindResourceShareInvitationByResourceShareARNAndStatus(conn, d.Id(), ram.ResourceShareInvitationStatusAccepted)
 
 	if err != nil && !tfawserr.ErrCodeEquals(err, ram.ErrCodeResourceShareInvitationArnNotFoundException) {
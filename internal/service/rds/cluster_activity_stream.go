@@ -92,6 +92,7 @@ func resourceClusterActivityStreamRead(ctx context.Context, d *schema.ResourceDa
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	log.Printf("[DEBUG] Finding DB Cluster (%s)", d.Id())
+	//lintignore:AWSR003
 	resp, err := FindDBClusterWithActivityStream(conn, d.Id())
 
 	if tfresource.NotFound(err) {
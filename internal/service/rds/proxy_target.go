@@ -130,6 +130,7 @@ func resourceProxyTargetRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	//lintignore:AWSR003
 	dbProxyTarget, err := FindDBProxyTarget(conn, dbProxyName, targetGroupName, targetType, rdsResourceId)
 
 	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) {
@@ -186,6 +186,7 @@ func resourceSnapshotCopyRead(ctx context.Context, d *schema.ResourceData, meta
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	//lintignore:AWSR003
 	snapshot, err := FindSnapshot(ctx, conn, d.Id())
 
 	if tfresource.NotFound(err) {
@@ -0,0 +1,310 @@
+package route53
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+const (
+	// changeBatchWindow is how long changes for a hosted zone are collected before they are sent.
+	changeBatchWindow = 2 * time.Second
+
+	// changeBatchMaxSize is the maximum number of ResourceRecord elements in a change batch.
+	// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+	changeBatchMaxSize = 1000
+)
+
+// changeBatcherKey identifies the change batcher of a hosted zone.
+type changeBatcherKey struct {
+	conn   *route53.Route53
+	zoneID string
+}
+
+// changeBatcherRef is a change batcher and the number of callers with changes queued or in flight.
+type changeBatcherRef struct {
+	batcher *changeBatcher
+	callers int
+}
+
+var (
+	// changeBatchers holds the change batcher of each hosted zone while it is in use.
+	changeBatchers   = make(map[changeBatcherKey]*changeBatcherRef)
+	changeBatchersMu sync.Mutex
+)
+
+// ChangeRecordSets applies the specified changes to the specified hosted zone and waits for them to be INSYNC.
+// Changes to the same hosted zone submitted at about the same time, e.g. by different aws_route53_record resources,
+// are coalesced into a single ChangeResourceRecordSets call with a single INSYNC waiter.
+// The changes of each call are applied atomically and errors are reported to the call whose changes caused them.
+func ChangeRecordSets(conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	zoneID = CleanZoneID(zoneID)
+
+	b, release := acquireChangeBatcher(changeBatcherKey{conn: conn, zoneID: zoneID}, func() *changeBatcher {
+		return newChangeBatcher(
+			func(zoneID string, changes []*route53.Change) (*route53.ChangeInfo, error) {
+				input := &route53.ChangeResourceRecordSetsInput{
+					ChangeBatch: &route53.ChangeBatch{
+						Comment: aws.String(changeBatchComment(changes)),
+						Changes: changes,
+					},
+					HostedZoneId: aws.String(zoneID),
+				}
+
+				// A hosted zone that isn't found is only retried when records are created or updated, as it may have just
+				// been created. Deletions fail immediately so that records of a deleted hosted zone are treated as deleted.
+				if changesDeleteOnly(changes) {
+					output, err := conn.ChangeResourceRecordSets(input)
+
+					if err != nil {
+						return nil, err
+					}
+
+					return output.ChangeInfo, nil
+				}
+
+				output, err := ChangeRecordSet(conn, input)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return output.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo, nil
+			},
+			func(changeInfo *route53.ChangeInfo) error {
+				return WaitForRecordSetToSync(conn, CleanChangeID(aws.StringValue(changeInfo.Id)))
+			},
+		)
+	})
+	defer release()
+
+	return b.change(zoneID, changes)
+}
+
+// acquireChangeBatcher returns the change batcher for the key, creating it if necessary,
+// and a function that must be called once the caller's changes have been applied.
+// The batcher is evicted once no caller has changes queued or in flight, so batchers
+// of deleted hosted zones are not retained.
+func acquireChangeBatcher(key changeBatcherKey, newBatcher func() *changeBatcher) (*changeBatcher, func()) {
+	changeBatchersMu.Lock()
+	defer changeBatchersMu.Unlock()
+
+	ref, ok := changeBatchers[key]
+
+	if !ok {
+		ref = &changeBatcherRef{batcher: newBatcher()}
+		changeBatchers[key] = ref
+	}
+
+	ref.callers++
+
+	return ref.batcher, func() {
+		changeBatchersMu.Lock()
+		defer changeBatchersMu.Unlock()
+
+		if ref.callers--; ref.callers == 0 {
+			delete(changeBatchers, key)
+		}
+	}
+}
+
+// changeBatchComment returns the comment of a change batch.
+func changeBatchComment(changes []*route53.Change) string {
+	if changesDeleteOnly(changes) {
+		return "Deleted by Terraform"
+	}
+
+	return "Managed by Terraform"
+}
+
+// changesDeleteOnly returns whether all of the changes are deletions.
+func changesDeleteOnly(changes []*route53.Change) bool {
+	for _, change := range changes {
+		if aws.StringValue(change.Action) != route53.ChangeActionDelete {
+			return false
+		}
+	}
+
+	return true
+}
+
+// changeBatcher coalesces record set changes per hosted zone.
+type changeBatcher struct {
+	apply   func(zoneID string, changes []*route53.Change) (*route53.ChangeInfo, error)
+	maxSize int
+	mu      sync.Mutex
+	pending map[string]*changeBatch
+	wait    func(changeInfo *route53.ChangeInfo) error
+	window  time.Duration
+}
+
+// changeBatch is a set of pending change requests for a hosted zone.
+type changeBatch struct {
+	requests []*changeRequest
+	size     int
+}
+
+// changeRequest is the changes of a single caller and the channel its result is delivered on.
+type changeRequest struct {
+	changes []*route53.Change
+	result  chan error
+}
+
+func newChangeBatcher(apply func(string, []*route53.Change) (*route53.ChangeInfo, error), wait func(*route53.ChangeInfo) error) *changeBatcher {
+	return &changeBatcher{
+		apply:   apply,
+		maxSize: changeBatchMaxSize,
+		pending: make(map[string]*changeBatch),
+		wait:    wait,
+		window:  changeBatchWindow,
+	}
+}
+
+// change queues the changes for the hosted zone and blocks until they are applied and INSYNC.
+func (b *changeBatcher) change(zoneID string, changes []*route53.Change) error {
+	request := &changeRequest{
+		changes: changes,
+		result:  make(chan error, 1),
+	}
+	size := changesSize(changes)
+
+	b.mu.Lock()
+
+	batch, ok := b.pending[zoneID]
+
+	// Send the pending batch now if the request doesn't fit.
+	if ok && batch.size+size > b.maxSize {
+		delete(b.pending, zoneID)
+		go b.send(zoneID, batch)
+		ok = false
+	}
+
+	if !ok {
+		batch = &changeBatch{}
+		b.pending[zoneID] = batch
+
+		time.AfterFunc(b.window, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			// The batch has already been sent if it was full.
+			if b.pending[zoneID] != batch {
+				return
+			}
+
+			delete(b.pending, zoneID)
+			go b.send(zoneID, batch)
+		})
+	}
+
+	batch.requests = append(batch.requests, request)
+	batch.size += size
+
+	b.mu.Unlock()
+
+	return <-request.result
+}
+
+// send applies a batch of change requests and delivers each request's result.
+// A batch is sent exactly once, either when a request overflows it or when its window ends.
+// Route 53 applies a change batch atomically, so if a batch of several requests fails then each
+// request is retried on its own so that the error is reported to the request that caused it.
+func (b *changeBatcher) send(zoneID string, batch *changeBatch) {
+	requests := batch.requests
+
+	if len(requests) == 1 {
+		requests[0].result <- b.applyAndWait(zoneID, requests[0].changes)
+		return
+	}
+
+	var changes []*route53.Change
+
+	for _, request := range requests {
+		changes = append(changes, request.changes...)
+	}
+
+	log.Printf("[DEBUG] Changing %d Route 53 record sets in Hosted Zone (%s) for %d resources", len(changes), zoneID, len(requests))
+	changeInfo, err := b.apply(zoneID, changes)
+
+	if err != nil {
+		log.Printf("[WARN] Route 53 change batch for Hosted Zone (%s) failed, retrying changes individually: %s", zoneID, err)
+
+		var wg sync.WaitGroup
+
+		for _, request := range requests {
+			wg.Add(1)
+
+			go func(request *changeRequest) {
+				defer wg.Done()
+
+				request.result <- b.applyAndWait(zoneID, request.changes)
+			}(request)
+		}
+
+		wg.Wait()
+
+		return
+	}
+
+	if err = b.wait(changeInfo); err != nil {
+		err = &changeSyncError{changeID: aws.StringValue(changeInfo.Id), err: err}
+	}
+
+	for _, request := range requests {
+		request.result <- err
+	}
+}
+
+func (b *changeBatcher) applyAndWait(zoneID string, changes []*route53.Change) error {
+	changeInfo, err := b.apply(zoneID, changes)
+
+	if err != nil {
+		return err
+	}
+
+	if err := b.wait(changeInfo); err != nil {
+		return &changeSyncError{changeID: aws.StringValue(changeInfo.Id), err: err}
+	}
+
+	return nil
+}
+
+// changeSyncError is returned when changes were applied but waiting for them to be INSYNC failed.
+type changeSyncError struct {
+	changeID string
+	err      error
+}
+
+func (e *changeSyncError) Error() string {
+	return fmt.Sprintf("waiting for Route 53 change (%s) to sync: %s", e.changeID, e.err)
+}
+
+func (e *changeSyncError) Unwrap() error {
+	return e.err
+}
+
+// changesSize returns the number of ResourceRecord elements in the changes as counted towards the change batch limit.
+// Alias records count as one element and UPSERT changes count twice.
+func changesSize(changes []*route53.Change) int {
+	var size int
+
+	for _, change := range changes {
+		n := 1
+
+		if change.ResourceRecordSet != nil && len(change.ResourceRecordSet.ResourceRecords) > 0 {
+			n = len(change.ResourceRecordSet.ResourceRecords)
+		}
+
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			n *= 2
+		}
+
+		size += n
+	}
+
+	return size
+}
@@ -0,0 +1,274 @@
+package route53
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+type testChangeAPI struct {
+	mu      sync.Mutex
+	applied [][]*route53.Change
+	waited  int
+}
+
+func (api *testChangeAPI) apply(zoneID string, changes []*route53.Change) (*route53.ChangeInfo, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	for _, change := range changes {
+		if aws.StringValue(change.ResourceRecordSet.Name) == "invalid" {
+			return nil, errors.New("InvalidChangeBatch")
+		}
+	}
+
+	api.applied = append(api.applied, changes)
+
+	return &route53.ChangeInfo{Id: aws.String("C1")}, nil
+}
+
+func (api *testChangeAPI) wait(changeInfo *route53.ChangeInfo) error {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.waited++
+
+	return nil
+}
+
+func testChange(action, name string) []*route53.Change {
+	return []*route53.Change{
+		{
+			Action: aws.String(action),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name:            aws.String(name),
+				ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("127.0.0.1")}},
+			},
+		},
+	}
+}
+
+func testChangeBatcherRun(b *changeBatcher, requests map[string][]*route53.Change) map[string]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]error)
+
+	for name, changes := range requests {
+		wg.Add(1)
+
+		go func(name string, changes []*route53.Change) {
+			defer wg.Done()
+
+			err := b.change("Z1", changes)
+
+			mu.Lock()
+			results[name] = err
+			mu.Unlock()
+		}(name, changes)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+func TestChangeBatcherCoalesces(t *testing.T) {
+	api := &testChangeAPI{}
+	b := newChangeBatcher(api.apply, api.wait)
+	b.window = 200 * time.Millisecond
+
+	results := testChangeBatcherRun(b, map[string][]*route53.Change{
+		"a": testChange(route53.ChangeActionCreate, "a"),
+		"b": testChange(route53.ChangeActionCreate, "b"),
+		"c": testChange(route53.ChangeActionDelete, "c"),
+	})
+
+	for name, err := range results {
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+		}
+	}
+
+	if got, want := len(api.applied), 1; got != want {
+		t.Fatalf("got %d change batches, want %d", got, want)
+	}
+
+	if got, want := len(api.applied[0]), 3; got != want {
+		t.Errorf("got %d changes, want %d", got, want)
+	}
+
+	if got, want := api.waited, 1; got != want {
+		t.Errorf("got %d waiters, want %d", got, want)
+	}
+}
+
+func TestChangeBatcherMaxSize(t *testing.T) {
+	api := &testChangeAPI{}
+	b := newChangeBatcher(api.apply, api.wait)
+	b.maxSize = 4
+	b.window = 200 * time.Millisecond
+
+	// Each UPSERT of a single record counts twice.
+	results := testChangeBatcherRun(b, map[string][]*route53.Change{
+		"a": testChange(route53.ChangeActionUpsert, "a"),
+		"b": testChange(route53.ChangeActionUpsert, "b"),
+		"c": testChange(route53.ChangeActionUpsert, "c"),
+	})
+
+	for name, err := range results {
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+		}
+	}
+
+	if got, want := len(api.applied), 2; got != want {
+		t.Fatalf("got %d change batches, want %d", got, want)
+	}
+
+	for _, changes := range api.applied {
+		if size := changesSize(changes); size > b.maxSize {
+			t.Errorf("change batch size %d exceeds %d", size, b.maxSize)
+		}
+	}
+}
+
+func TestChangeBatcherErrorAttribution(t *testing.T) {
+	api := &testChangeAPI{}
+	b := newChangeBatcher(api.apply, api.wait)
+	b.window = 200 * time.Millisecond
+
+	results := testChangeBatcherRun(b, map[string][]*route53.Change{
+		"a":       testChange(route53.ChangeActionCreate, "a"),
+		"invalid": testChange(route53.ChangeActionCreate, "invalid"),
+	})
+
+	if err := results["a"]; err != nil {
+		t.Errorf("a: unexpected error: %s", err)
+	}
+
+	if err := results["invalid"]; err == nil {
+		t.Error("invalid: expected error, got none")
+	}
+
+	if got, want := len(api.applied), 1; got != want {
+		t.Errorf("got %d change batches, want %d", got, want)
+	}
+}
+
+func TestAcquireChangeBatcherEvicts(t *testing.T) {
+	key := changeBatcherKey{zoneID: "Z1"}
+	created := 0
+	newBatcher := func() *changeBatcher {
+		created++
+
+		return newChangeBatcher(nil, nil)
+	}
+
+	b1, release1 := acquireChangeBatcher(key, newBatcher)
+	b2, release2 := acquireChangeBatcher(key, newBatcher)
+
+	if b1 != b2 {
+		t.Error("expected callers with changes in flight to share a batcher")
+	}
+
+	release1()
+
+	if _, ok := changeBatchers[key]; !ok {
+		t.Error("expected batcher to be retained while a caller has changes in flight")
+	}
+
+	release2()
+
+	if _, ok := changeBatchers[key]; ok {
+		t.Error("expected batcher to be evicted once its queue has drained")
+	}
+
+	_, release3 := acquireChangeBatcher(key, newBatcher)
+	release3()
+
+	if got, want := created, 2; got != want {
+		t.Errorf("got %d batchers created, want %d", got, want)
+	}
+}
+
+func TestChangeBatchComment(t *testing.T) {
+	testCases := []struct {
+		name    string
+		changes []*route53.Change
+		want    string
+	}{
+		{
+			name:    "create",
+			changes: testChange(route53.ChangeActionCreate, "a"),
+			want:    "Managed by Terraform",
+		},
+		{
+			name:    "delete",
+			changes: append(testChange(route53.ChangeActionDelete, "a"), testChange(route53.ChangeActionDelete, "b")...),
+			want:    "Deleted by Terraform",
+		},
+		{
+			name:    "mixed",
+			changes: append(testChange(route53.ChangeActionDelete, "a"), testChange(route53.ChangeActionCreate, "b")...),
+			want:    "Managed by Terraform",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := changeBatchComment(testCase.changes); got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestChangesSize(t *testing.T) {
+	alias := []*route53.Change{
+		{
+			Action: aws.String(route53.ChangeActionCreate),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				AliasTarget: &route53.AliasTarget{DNSName: aws.String("example.com")},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		changes []*route53.Change
+		want    int
+	}{
+		{
+			name:    "create",
+			changes: testChange(route53.ChangeActionCreate, "a"),
+			want:    1,
+		},
+		{
+			name:    "upsert",
+			changes: testChange(route53.ChangeActionUpsert, "a"),
+			want:    2,
+		},
+		{
+			name:    "alias",
+			changes: alias,
+			want:    1,
+		},
+		{
+			name:    "multiple",
+			changes: append(testChange(route53.ChangeActionDelete, "a"), testChange(route53.ChangeActionUpsert, "b")...),
+			want:    3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := changesSize(testCase.changes); got != testCase.want {
+				t.Errorf("got %d, want %d", got, testCase.want)
+			}
+		})
+	}
+}
@@ -73,6 +73,7 @@ func resourceHostedZoneDNSSECCreate(d *schema.ResourceData, meta interface{}) er
 func resourceHostedZoneDNSSECRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).Route53Conn
 
+	//lintignore:AWSR003
 	hostedZoneDnssec, err := FindHostedZoneDNSSEC(conn, d.Id())
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, route53.ErrCodeDNSSECNotFound) {
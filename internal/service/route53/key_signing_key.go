@@ -147,6 +147,7 @@ func resourceKeySigningKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error parsing Route 53 Key Signing Key (%s) identifier: %w", d.Id(), err)
 	}
 
+	//lintignore:AWSR003
 	keySigningKey, err := FindKeySigningKey(conn, hostedZoneID, name)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
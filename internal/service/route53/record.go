@@ -330,38 +330,29 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Delete the old and create the new records in a single batch. We abuse
-	// StateChangeConf for this to retry for us since Route53 sometimes returns
-	// errors about another operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(route53.ChangeActionDelete),
-				ResourceRecordSet: oldRec,
-			},
-			{
-				Action:            aws.String(route53.ChangeActionCreate),
-				ResourceRecordSet: rec,
-			},
+	// Delete the old and create the new records within the same change batch.
+	// The changes may be batched with changes of other records in the same zone.
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: oldRec,
+		},
+		{
+			Action:            aws.String(route53.ChangeActionCreate),
+			ResourceRecordSet: rec,
 		},
-	}
-
-	input := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
-		ChangeBatch:  changeBatch,
 	}
 
 	log.Printf("[DEBUG] Updating resource records for zone: %s, name: %s\n\n%s",
-		zone, aws.StringValue(rec.Name), input)
+		zone, aws.StringValue(rec.Name), changes)
 
-	respRaw, err := ChangeRecordSet(conn, input)
-	if err != nil {
+	err = ChangeRecordSets(conn, CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id)), changes)
+
+	var syncErr *changeSyncError
+	if err != nil && !errors.As(err, &syncErr) {
 		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
 	}
 
-	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
-
 	// Generate an ID
 	vars := []string{
 		zone,
@@ -374,7 +365,6 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(strings.Join(vars, "_"))
 
-	err = WaitForRecordSetToSync(conn, CleanChangeID(aws.StringValue(changeInfo.Id)))
 	if err != nil {
 		return err
 	}
@@ -383,6 +373,7 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
+// lintignore:AWSR004
 func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).Route53Conn
 	zone := CleanZoneID(d.Get("zone_id").(string))
@@ -412,34 +403,25 @@ func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 		action = route53.ChangeActionCreate
 	}
 
-	// Create the new records. We abuse StateChangeConf for this to
-	// retry for us since Route53 sometimes returns errors about another
-	// operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(action),
-				ResourceRecordSet: rec,
-			},
+	// Create the new records. The change may be batched with changes of
+	// other records in the same zone.
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(action),
+			ResourceRecordSet: rec,
 		},
 	}
 
-	req := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
-		ChangeBatch:  changeBatch,
-	}
-
 	log.Printf("[DEBUG] Creating resource records for zone: %s, name: %s\n\n%s",
-		zone, aws.StringValue(rec.Name), req)
+		zone, aws.StringValue(rec.Name), changes)
 
-	respRaw, err := ChangeRecordSet(conn, req)
-	if err != nil {
+	err = ChangeRecordSets(conn, CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id)), changes)
+
+	var syncErr *changeSyncError
+	if err != nil && !errors.As(err, &syncErr) {
 		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
 	}
 
-	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
-
 	// Generate an ID
 	vars := []string{
 		zone,
@@ -452,7 +434,6 @@ func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(strings.Join(vars, "_"))
 
-	err = WaitForRecordSetToSync(conn, CleanChangeID(aws.StringValue(changeInfo.Id)))
 	if err != nil {
 		return err
 	}
@@ -731,36 +712,35 @@ func resourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	// Change batch for deleting
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Deleted by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(route53.ChangeActionDelete),
-				ResourceRecordSet: rec,
-			},
+	// Change batch for deleting. The change may be batched with changes of
+	// other records in the same zone; a batch of only deletions is commented
+	// "Deleted by Terraform".
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: rec,
 		},
 	}
 
 	zone := CleanZoneID(d.Get("zone_id").(string))
 
-	req := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: aws.String(CleanZoneID(zone)),
-		ChangeBatch:  changeBatch,
-	}
+	err = ChangeRecordSets(conn, zone, changes)
 
-	respRaw, err := DeleteRecordSet(conn, req)
-	if err != nil {
-		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeInvalidChangeBatch) {
+		log.Printf("[INFO] Route 53 record (%s) already deleted", d.Id())
+		return nil
 	}
 
-	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
-	if changeInfo == nil {
-		log.Printf("[INFO] No ChangeInfo Found. Waiting for Sync not required")
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		log.Printf("[INFO] Route 53 Hosted Zone (%s) of record (%s) already deleted", zone, d.Id())
 		return nil
 	}
 
-	err = WaitForRecordSetToSync(conn, CleanChangeID(aws.StringValue(changeInfo.Id)))
+	var syncErr *changeSyncError
+	if err != nil && !errors.As(err, &syncErr) {
+		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
+	}
+
 	return err
 }
 
@@ -0,0 +1,336 @@
+package route53
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// zoneFileEntry is a logical line of a zone file, i.e. with any parenthesized continuation lines joined.
+type zoneFileEntry struct {
+	blankOwner bool
+	line       int
+	tokens     []string
+}
+
+// zoneFileRDataNames is the indexes of the RDATA fields that are domain names, by record type.
+// Relative domain names in these fields are qualified with the origin.
+var zoneFileRDataNames = map[string][]int{
+	route53.RRTypeCname: {0},
+	route53.RRTypeMx:    {1},
+	route53.RRTypeNs:    {0},
+	route53.RRTypePtr:   {0},
+	route53.RRTypeSoa:   {0, 1},
+	route53.RRTypeSrv:   {3},
+}
+
+// parseZoneFile parses a BIND-format (RFC 1035) zone file into resource record sets.
+// Records with the same name and type are combined into one record set with the TTL of the first record.
+// The $ORIGIN and $TTL directives are supported, $INCLUDE and $GENERATE are not.
+// Names are returned in lower case, fully qualified and without the trailing period.
+func parseZoneFile(zoneFile, origin string) ([]*route53.ResourceRecordSet, error) {
+	entries, err := zoneFileEntries(zoneFile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	origin = strings.ToLower(FQDN(origin))
+
+	var defaultTTL, lastTTL *int64
+	var owner string
+	var recordSets []*route53.ResourceRecordSet
+	index := make(map[string]*route53.ResourceRecordSet)
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if strings.HasPrefix(tokens[0], "$") && !entry.blankOwner {
+			directive := strings.ToUpper(tokens[0])
+
+			if len(tokens) < 2 {
+				return nil, fmt.Errorf("line %d: %s requires an argument", entry.line, directive)
+			}
+
+			switch directive {
+			case "$ORIGIN":
+				origin = strings.ToLower(zoneFileName(tokens[1], origin))
+			case "$TTL":
+				ttl, err := parseZoneFileTTL(tokens[1])
+
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid $TTL: %w", entry.line, err)
+				}
+
+				defaultTTL = aws.Int64(ttl)
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive: %s", entry.line, directive)
+			}
+
+			continue
+		}
+
+		if entry.blankOwner {
+			if owner == "" {
+				return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+			}
+		} else {
+			owner = strings.ToLower(zoneFileName(tokens[0], origin))
+			tokens = tokens[1:]
+		}
+
+		// The optional TTL and class can appear in either order.
+		var ttl *int64
+
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if v, err := parseZoneFileTTL(tokens[0]); err == nil && ttl == nil {
+				ttl = aws.Int64(v)
+			} else if class := strings.ToUpper(tokens[0]); class == "CH" || class == "CS" || class == "HS" {
+				return nil, fmt.Errorf("line %d: unsupported class: %s", entry.line, class)
+			} else if class != "IN" {
+				break
+			}
+
+			tokens = tokens[1:]
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record has no type or data", entry.line)
+		}
+
+		rrType := strings.ToUpper(tokens[0])
+		rData := tokens[1:]
+
+		if !validRecordType(rrType) {
+			return nil, fmt.Errorf("line %d: unsupported record type: %s", entry.line, rrType)
+		}
+
+		for _, i := range zoneFileRDataNames[rrType] {
+			if i < len(rData) {
+				rData[i] = zoneFileName(rData[i], origin)
+			}
+		}
+
+		switch {
+		case ttl != nil:
+			lastTTL = ttl
+		case defaultTTL != nil:
+			ttl = defaultTTL
+		case lastTTL != nil:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: record has no TTL and there is no $TTL directive", entry.line)
+		}
+
+		name := strings.TrimSuffix(owner, ".")
+		value := strings.Join(rData, " ")
+		key := name + " " + rrType
+		recordSet, ok := index[key]
+
+		if !ok {
+			recordSet = &route53.ResourceRecordSet{
+				Name: aws.String(name),
+				TTL:  aws.Int64(*ttl),
+				Type: aws.String(rrType),
+			}
+			index[key] = recordSet
+			recordSets = append(recordSets, recordSet)
+		}
+
+		duplicate := false
+
+		for _, v := range recordSet.ResourceRecords {
+			if aws.StringValue(v.Value) == value {
+				duplicate = true
+				break
+			}
+		}
+
+		if !duplicate {
+			recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{
+				Value: aws.String(value),
+			})
+		}
+	}
+
+	return recordSets, nil
+}
+
+// zoneFileEntries splits a zone file into logical lines of tokens.
+// Comments are removed, parentheses join lines and quoted strings are single tokens that keep their quotes.
+func zoneFileEntries(zoneFile string) ([]*zoneFileEntry, error) {
+	var entries []*zoneFileEntry
+	var entry *zoneFileEntry
+	var token strings.Builder
+	var inToken, inQuotes, inComment bool
+	parens := 0
+	line := 1
+
+	endToken := func() {
+		if inToken {
+			entry.tokens = append(entry.tokens, token.String())
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	endEntry := func() {
+		endToken()
+
+		if entry != nil && len(entry.tokens) > 0 {
+			entries = append(entries, entry)
+		}
+
+		entry = nil
+	}
+
+	runes := []rune(zoneFile)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if entry == nil {
+			entry = &zoneFileEntry{
+				blankOwner: r == ' ' || r == '\t',
+				line:       line,
+			}
+		}
+
+		if inComment {
+			if r != '\n' {
+				continue
+			}
+
+			inComment = false
+		}
+
+		if r == '\\' && i+1 < len(runes) {
+			token.WriteRune(r)
+			token.WriteRune(runes[i+1])
+			inToken = true
+			i++
+
+			continue
+		}
+
+		if inQuotes {
+			if r == '\n' {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			}
+
+			token.WriteRune(r)
+
+			if r == '"' {
+				inQuotes = false
+			}
+
+			continue
+		}
+
+		switch {
+		case r == '"':
+			token.WriteRune(r)
+			inToken = true
+			inQuotes = true
+		case r == ';':
+			endToken()
+			inComment = true
+		case r == '(':
+			endToken()
+			parens++
+		case r == ')':
+			endToken()
+
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+
+			parens--
+		case r == '\n':
+			line++
+
+			if parens > 0 {
+				endToken()
+			} else {
+				endEntry()
+			}
+		case unicode.IsSpace(r):
+			endToken()
+		default:
+			token.WriteRune(r)
+			inToken = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+
+	if parens > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+
+	endEntry()
+
+	return entries, nil
+}
+
+// zoneFileName returns the fully qualified form of a zone file domain name.
+func zoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == ".":
+		return name + "."
+	default:
+		return name + "." + origin
+	}
+}
+
+// parseZoneFileTTL parses a TTL in seconds or in BIND's unit format, e.g. 1h30m.
+func parseZoneFileTTL(s string) (int64, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if v < 0 {
+			return 0, fmt.Errorf("negative TTL: %s", s)
+		}
+
+		return v, nil
+	}
+
+	units := map[rune]int64{
+		's': 1,
+		'm': 60,
+		'h': 60 * 60,
+		'd': 24 * 60 * 60,
+		'w': 7 * 24 * 60 * 60,
+	}
+
+	var ttl, n int64
+	digits := false
+
+	for _, r := range strings.ToLower(s) {
+		switch unit, ok := units[r]; {
+		case r >= '0' && r <= '9':
+			n = n*10 + int64(r-'0')
+			digits = true
+		case ok && digits:
+			ttl += n * unit
+			n = 0
+			digits = false
+		default:
+			return 0, fmt.Errorf("invalid TTL: %s", s)
+		}
+	}
+
+	if digits || s == "" {
+		return 0, fmt.Errorf("invalid TTL: %s", s)
+	}
+
+	return ttl, nil
+}
@@ -0,0 +1,299 @@
+package route53
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	testCases := []struct {
+		name    string
+		zone    string
+		want    []*route53.ResourceRecordSet
+		wantErr bool
+	}{
+		{
+			name: "basic",
+			zone: `
+$TTL 3600
+@	IN	SOA	ns1 hostmaster (
+			2022050101 ; serial
+			7200       ; refresh
+			900        ; retry
+			1209600    ; expire
+			86400 )    ; minimum
+	IN	NS	ns1.example.net.
+WWW	300	IN	A	192.0.2.1
+	IN	300	A	192.0.2.2
+mail		IN	MX	10 mail
+txt		TXT	"v=spf1 -all" ; comment
+`,
+			want: []*route53.ResourceRecordSet{
+				testZoneFileRecordSet("example.com", route53.RRTypeSoa, 3600, "ns1.example.com. hostmaster.example.com. 2022050101 7200 900 1209600 86400"),
+				testZoneFileRecordSet("example.com", route53.RRTypeNs, 3600, "ns1.example.net."),
+				testZoneFileRecordSet("www.example.com", route53.RRTypeA, 300, "192.0.2.1", "192.0.2.2"),
+				testZoneFileRecordSet("mail.example.com", route53.RRTypeMx, 3600, "10 mail.example.com."),
+				testZoneFileRecordSet("txt.example.com", route53.RRTypeTxt, 3600, `"v=spf1 -all"`),
+			},
+		},
+		{
+			name: "origin and units",
+			zone: `
+$ORIGIN sub
+a 1h30m A 192.0.2.1
+b CNAME a
+$ORIGIN other.example.com.
+* 1d TXT "a;b" "c\"d"
+`,
+			want: []*route53.ResourceRecordSet{
+				testZoneFileRecordSet("a.sub.example.com", route53.RRTypeA, 5400, "192.0.2.1"),
+				testZoneFileRecordSet("b.sub.example.com", route53.RRTypeCname, 5400, "a.sub.example.com."),
+				testZoneFileRecordSet("*.other.example.com", route53.RRTypeTxt, 86400, `"a;b" "c\"d"`),
+			},
+		},
+		{
+			name: "duplicate values",
+			zone: `
+a 60 A 192.0.2.1
+a 120 A 192.0.2.1
+`,
+			want: []*route53.ResourceRecordSet{
+				testZoneFileRecordSet("a.example.com", route53.RRTypeA, 60, "192.0.2.1"),
+			},
+		},
+		{
+			name:    "no TTL",
+			zone:    "a A 192.0.2.1",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported directive",
+			zone:    "$INCLUDE other.zone",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported class",
+			zone:    "a 60 CH A 192.0.2.1",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			zone:    "a 60 IN HINFO x86 Linux",
+			wantErr: true,
+		},
+		{
+			name:    "unbalanced parentheses",
+			zone:    "a 60 IN TXT ( \"x\"",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated quoted string",
+			zone:    "a 60 IN TXT \"x\n",
+			wantErr: true,
+		},
+		{
+			name:    "no owner",
+			zone:    "  60 IN A 192.0.2.1",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := parseZoneFile(testCase.zone, "Example.com")
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	testCases := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{value: "300", want: 300},
+		{value: "1W2D", want: 777600},
+		{value: "1h30m15s", want: 5415},
+		{value: "1h30", wantErr: true},
+		{value: "h", wantErr: true},
+		{value: "-1", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.value, func(t *testing.T) {
+			got, err := parseZoneFileTTL(testCase.value)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %d", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %d, want %d", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestZoneRecordsChanges(t *testing.T) {
+	actual := []*route53.ResourceRecordSet{
+		testZoneFileRecordSet("example.com.", route53.RRTypeSoa, 900, "ns1.example.com. hostmaster.example.com. 1 7200 900 1209600 86400"),
+		testZoneFileRecordSet("example.com.", route53.RRTypeNs, 172800, "ns1.example.com."),
+		testZoneFileRecordSet("a.example.com.", route53.RRTypeA, 300, "192.0.2.1"),
+		testZoneFileRecordSet("b.example.com.", route53.RRTypeA, 300, "192.0.2.1"),
+		testZoneFileRecordSet("\\052.example.com.", route53.RRTypeA, 300, "192.0.2.1"),
+		{
+			AliasTarget: &route53.AliasTarget{DNSName: aws.String("example.net")},
+			Name:        aws.String("alias.example.com."),
+			Type:        aws.String(route53.RRTypeA),
+		},
+		{
+			Name:            aws.String("weighted.example.com."),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+			SetIdentifier:   aws.String("one"),
+			TTL:             aws.Int64(300),
+			Type:            aws.String(route53.RRTypeA),
+			Weight:          aws.Int64(1),
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		desired []*route53.ResourceRecordSet
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "changes",
+			desired: []*route53.ResourceRecordSet{
+				testZoneFileRecordSet("a.example.com", route53.RRTypeA, 300, "192.0.2.1"),
+				testZoneFileRecordSet("*.example.com", route53.RRTypeA, 60, "192.0.2.1"),
+				testZoneFileRecordSet("c.example.com", route53.RRTypeA, 300, "192.0.2.1"),
+			},
+			want: []string{
+				"DELETE b.example.com A",
+				"UPSERT *.example.com A",
+				"UPSERT c.example.com A",
+			},
+		},
+		{
+			name: "alias conflict",
+			desired: []*route53.ResourceRecordSet{
+				testZoneFileRecordSet("Alias.example.com", route53.RRTypeA, 300, "192.0.2.1"),
+			},
+			wantErr: "record alias.example.com A conflicts",
+		},
+		{
+			name: "set identifier conflict",
+			desired: []*route53.ResourceRecordSet{
+				testZoneFileRecordSet("weighted.example.com", route53.RRTypeA, 300, "192.0.2.1"),
+			},
+			wantErr: "record weighted.example.com A conflicts",
+		},
+		{
+			name: "alias other type",
+			desired: []*route53.ResourceRecordSet{
+				testZoneFileRecordSet("alias.example.com", route53.RRTypeTxt, 300, "\"v=spf1 -all\""),
+			},
+			want: []string{
+				"DELETE *.example.com A",
+				"DELETE a.example.com A",
+				"DELETE b.example.com A",
+				"UPSERT alias.example.com TXT",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			changes, err := zoneRecordsChanges("example.com", testCase.desired, actual, false)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("got error %v, want %q", err, testCase.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+
+			for _, change := range changes {
+				got = append(got, aws.StringValue(change.Action)+" "+zoneRecordsKey(change.ResourceRecordSet))
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestZoneRecordsChunks(t *testing.T) {
+	var changes []*route53.Change
+
+	for i := 0; i < 5; i++ {
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: testZoneFileRecordSet("a.example.com", route53.RRTypeA, 300, "192.0.2.1"),
+		})
+	}
+
+	chunks := zoneRecordsChunks(changes, 4)
+
+	if got, want := len(chunks), 3; got != want {
+		t.Fatalf("got %d chunks, want %d", got, want)
+	}
+
+	for _, chunk := range chunks {
+		if size := changesSize(chunk); size > 4 {
+			t.Errorf("chunk size %d exceeds 4", size)
+		}
+	}
+}
+
+func testZoneFileRecordSet(name, rrType string, ttl int64, values ...string) *route53.ResourceRecordSet {
+	recordSet := &route53.ResourceRecordSet{
+		Name: aws.String(name),
+		TTL:  aws.Int64(ttl),
+		Type: aws.String(rrType),
+	}
+
+	for _, v := range values {
+		recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: aws.String(v)})
+	}
+
+	return recordSet
+}
@@ -0,0 +1,549 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceZoneRecordsCreate,
+		ReadWithoutTimeout:   resourceZoneRecordsRead,
+		UpdateWithoutTimeout: resourceZoneRecordsUpdate,
+		DeleteWithoutTimeout: resourceZoneRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceZoneRecordsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"manage_soa_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"record": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"zone_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							StateFunc: func(v interface{}) string {
+								return strings.ToLower(strings.TrimSuffix(v.(string), "."))
+							},
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 2147483647),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+					},
+				},
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"zone_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"record"},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceZoneRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+
+	if err := zoneRecordsSync(conn, d, zoneID); err != nil {
+		return diag.Errorf("error creating Route53 Zone Records (%s): %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceZoneRecordsRead(ctx, d, meta)
+}
+
+func resourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	hostedZone, err := FindHostedZoneByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 Zone Records %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	recordSets, err := FindResourceRecordSetsByZoneID(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error reading Route53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	zoneName := zoneRecordsName(aws.StringValue(hostedZone.HostedZone.Name))
+	manageSOANS := d.Get("manage_soa_ns").(bool)
+	var managed []*route53.ResourceRecordSet
+
+	for _, recordSet := range recordSets {
+		if zoneRecordsManaged(recordSet, zoneName, manageSOANS) {
+			managed = append(managed, recordSet)
+		}
+	}
+
+	d.Set("manage_soa_ns", manageSOANS)
+	if err := d.Set("records", flattenZoneRecords(managed)); err != nil {
+		return diag.Errorf("error setting records: %s", err)
+	}
+	d.Set("zone_id", d.Id())
+	d.Set("zone_name", zoneName)
+
+	return nil
+}
+
+func resourceZoneRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	if err := zoneRecordsSync(conn, d, d.Id()); err != nil {
+		return diag.Errorf("error updating Route53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	return resourceZoneRecordsRead(ctx, d, meta)
+}
+
+func resourceZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	hostedZone, err := FindHostedZoneByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	recordSets, err := FindResourceRecordSetsByZoneID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	// The apex SOA and NS record sets can't be deleted, whether or not they are managed.
+	zoneName := zoneRecordsName(aws.StringValue(hostedZone.HostedZone.Name))
+	changes, err := zoneRecordsChanges(zoneName, nil, recordSets, d.Get("manage_soa_ns").(bool))
+
+	if err != nil {
+		return diag.Errorf("error deleting Route53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Route53 Zone Records (%s): %d record sets", d.Id(), len(changes))
+	if err := zoneRecordsApply(conn, d.Id(), changes); err != nil {
+		return diag.Errorf("error deleting Route53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceZoneRecordsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("zone_id") || !diff.NewValueKnown("zone_file") || !diff.NewValueKnown("record") || diff.HasChange("manage_soa_ns") {
+		return diff.SetNewComputed("records")
+	}
+
+	zoneName := diff.Get("zone_name").(string)
+
+	if diff.Id() == "" {
+		conn := meta.(*conns.AWSClient).Route53Conn
+		zoneID := CleanZoneID(diff.Get("zone_id").(string))
+
+		hostedZone, err := FindHostedZoneByID(conn, zoneID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Route53 Hosted Zone (%s): %w", zoneID, err)
+		}
+
+		zoneName = zoneRecordsName(aws.StringValue(hostedZone.HostedZone.Name))
+
+		if err := diff.SetNew("zone_name", zoneName); err != nil {
+			return err
+		}
+	}
+
+	desired, err := expandZoneRecords(diff, zoneName)
+
+	if err != nil {
+		return err
+	}
+
+	// Apex SOA and NS record sets that are managed but not configured are left unchanged.
+	if diff.Get("manage_soa_ns").(bool) {
+		o, _ := diff.GetChange("records")
+		configured := make(map[string]bool)
+
+		for _, recordSet := range desired {
+			configured[zoneRecordsKey(recordSet)] = true
+		}
+
+		for _, recordSet := range expandZoneRecordsAttribute(o.(*schema.Set).List()) {
+			if zoneRecordsApex(recordSet, zoneName) && !configured[zoneRecordsKey(recordSet)] {
+				desired = append(desired, recordSet)
+			}
+		}
+	}
+
+	return diff.SetNew("records", flattenZoneRecords(desired))
+}
+
+// zoneRecordsSync makes the hosted zone's managed record sets match the configuration.
+func zoneRecordsSync(conn *route53.Route53, d *schema.ResourceData, zoneID string) error {
+	hostedZone, err := FindHostedZoneByID(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("reading Route53 Hosted Zone: %w", err)
+	}
+
+	zoneName := zoneRecordsName(aws.StringValue(hostedZone.HostedZone.Name))
+	desired, err := expandZoneRecords(d, zoneName)
+
+	if err != nil {
+		return err
+	}
+
+	actual, err := FindResourceRecordSetsByZoneID(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("reading record sets: %w", err)
+	}
+
+	changes, err := zoneRecordsChanges(zoneName, desired, actual, d.Get("manage_soa_ns").(bool))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Changing Route53 Zone Records (%s): %d changes", zoneID, len(changes))
+	return zoneRecordsApply(conn, zoneID, changes)
+}
+
+// zoneRecordsApply applies the changes in order in change batches of at most changeBatchMaxSize.
+func zoneRecordsApply(conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	for _, chunk := range zoneRecordsChunks(changes, changeBatchMaxSize) {
+		if err := ChangeRecordSets(conn, zoneID, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// zoneRecordsChunks splits the changes, in order, into chunks whose changesSize is at most maxSize.
+func zoneRecordsChunks(changes []*route53.Change, maxSize int) [][]*route53.Change {
+	var chunks [][]*route53.Change
+	var chunk []*route53.Change
+	size := 0
+
+	for _, change := range changes {
+		n := changesSize([]*route53.Change{change})
+
+		if len(chunk) > 0 && size+n > maxSize {
+			chunks = append(chunks, chunk)
+			chunk = nil
+			size = 0
+		}
+
+		chunk = append(chunk, change)
+		size += n
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// zoneRecordsChanges returns the changes that make the managed record sets in actual match desired.
+// Record sets that are no longer desired are deleted first, then new and changed record sets are upserted.
+// The apex SOA and NS record sets are never deleted.
+// A desired record set with the same name and type as an unmanaged alias or routing policy record set is an error,
+// as upserting it would change or replace the unmanaged record set.
+func zoneRecordsChanges(zoneName string, desired, actual []*route53.ResourceRecordSet, manageSOANS bool) ([]*route53.Change, error) {
+	var changes []*route53.Change
+	desiredByKey := make(map[string]*route53.ResourceRecordSet)
+	actualByKey := make(map[string]*route53.ResourceRecordSet)
+	unmanaged := make(map[string]bool)
+
+	for _, recordSet := range desired {
+		desiredByKey[zoneRecordsKey(recordSet)] = recordSet
+	}
+
+	for _, recordSet := range actual {
+		key := zoneRecordsKey(recordSet)
+
+		if zoneRecordsManaged(recordSet, zoneName, manageSOANS) {
+			actualByKey[key] = recordSet
+		} else if !zoneRecordsApex(recordSet, zoneName) {
+			unmanaged[key] = true
+		}
+	}
+
+	for _, key := range zoneRecordsSortedKeys(desiredByKey) {
+		if unmanaged[key] {
+			return nil, fmt.Errorf("record %s conflicts with an alias or routing policy record set that is not managed by this resource", key)
+		}
+	}
+
+	for _, key := range zoneRecordsSortedKeys(actualByKey) {
+		if recordSet := actualByKey[key]; desiredByKey[key] == nil && !zoneRecordsApex(recordSet, zoneName) {
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: recordSet,
+			})
+		}
+	}
+
+	for _, key := range zoneRecordsSortedKeys(desiredByKey) {
+		if recordSet := desiredByKey[key]; !zoneRecordsEqual(recordSet, actualByKey[key]) {
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionUpsert),
+				ResourceRecordSet: recordSet,
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+// expandZoneRecords returns the desired record sets from the zone_file or record arguments.
+// Apex SOA and NS record sets are omitted unless manage_soa_ns is set.
+func expandZoneRecords(d interface{ Get(string) interface{} }, zoneName string) ([]*route53.ResourceRecordSet, error) {
+	manageSOANS := d.Get("manage_soa_ns").(bool)
+	var recordSets []*route53.ResourceRecordSet
+
+	if v := d.Get("zone_file").(string); v != "" {
+		var err error
+		recordSets, err = parseZoneFile(v, zoneName)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing zone_file: %w", err)
+		}
+	} else {
+		keys := make(map[string]bool)
+
+		for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			rrType := tfMap["type"].(string)
+			recordSet := &route53.ResourceRecordSet{
+				Name:            aws.String(ExpandRecordName(tfMap["name"].(string), zoneName)),
+				ResourceRecords: expandResourceRecords(tfMap["records"].(*schema.Set).List(), rrType),
+				TTL:             aws.Int64(int64(tfMap["ttl"].(int))),
+				Type:            aws.String(rrType),
+			}
+			key := zoneRecordsKey(recordSet)
+
+			if keys[key] {
+				return nil, fmt.Errorf("duplicate record: %s", key)
+			}
+
+			keys[key] = true
+			recordSets = append(recordSets, recordSet)
+		}
+	}
+
+	var output []*route53.ResourceRecordSet
+
+	for _, recordSet := range recordSets {
+		name := aws.StringValue(recordSet.Name)
+
+		if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+			return nil, fmt.Errorf("record %s is not in zone %s", zoneRecordsKey(recordSet), zoneName)
+		}
+
+		if zoneRecordsApex(recordSet, zoneName) && !manageSOANS {
+			continue
+		}
+
+		output = append(output, recordSet)
+	}
+
+	return output, nil
+}
+
+func expandZoneRecordsAttribute(tfList []interface{}) []*route53.ResourceRecordSet {
+	var recordSets []*route53.ResourceRecordSet
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+		recordSet := &route53.ResourceRecordSet{
+			Name: aws.String(tfMap["name"].(string)),
+			TTL:  aws.Int64(int64(tfMap["ttl"].(int))),
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		for _, v := range flex.ExpandStringSet(tfMap["records"].(*schema.Set)) {
+			recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: v})
+		}
+
+		recordSets = append(recordSets, recordSet)
+	}
+
+	return recordSets
+}
+
+func flattenZoneRecords(recordSets []*route53.ResourceRecordSet) []interface{} {
+	var tfList []interface{}
+
+	for _, recordSet := range recordSets {
+		var values []string
+
+		for _, v := range recordSet.ResourceRecords {
+			values = append(values, aws.StringValue(v.Value))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":    zoneRecordsName(aws.StringValue(recordSet.Name)),
+			"records": flex.FlattenStringSet(aws.StringSlice(values)),
+			"ttl":     int(aws.Int64Value(recordSet.TTL)),
+			"type":    aws.StringValue(recordSet.Type),
+		})
+	}
+
+	return tfList
+}
+
+// zoneRecordsManaged returns whether the record set is managed by aws_route53_zone_records.
+// Alias record sets, record sets with a routing policy and, unless manageSOANS is set, the apex SOA and NS record sets are not.
+func zoneRecordsManaged(recordSet *route53.ResourceRecordSet, zoneName string, manageSOANS bool) bool {
+	if recordSet.AliasTarget != nil || recordSet.SetIdentifier != nil || recordSet.TrafficPolicyInstanceId != nil {
+		return false
+	}
+
+	return manageSOANS || !zoneRecordsApex(recordSet, zoneName)
+}
+
+// zoneRecordsApex returns whether the record set is the zone's SOA or NS record set.
+func zoneRecordsApex(recordSet *route53.ResourceRecordSet, zoneName string) bool {
+	switch aws.StringValue(recordSet.Type) {
+	case route53.RRTypeSoa, route53.RRTypeNs:
+		return zoneRecordsName(aws.StringValue(recordSet.Name)) == zoneName
+	default:
+		return false
+	}
+}
+
+func zoneRecordsEqual(a, b *route53.ResourceRecordSet) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if aws.Int64Value(a.TTL) != aws.Int64Value(b.TTL) || len(a.ResourceRecords) != len(b.ResourceRecords) {
+		return false
+	}
+
+	values := make(map[string]bool)
+
+	for _, v := range a.ResourceRecords {
+		values[aws.StringValue(v.Value)] = true
+	}
+
+	for _, v := range b.ResourceRecords {
+		if !values[aws.StringValue(v.Value)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// zoneRecordsKey returns the normalized name and type of the record set.
+func zoneRecordsKey(recordSet *route53.ResourceRecordSet) string {
+	return zoneRecordsName(aws.StringValue(recordSet.Name)) + " " + aws.StringValue(recordSet.Type)
+}
+
+// zoneRecordsName returns the record or zone name in lower case, with Route 53's octal escapes decoded and without the trailing period.
+func zoneRecordsName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(CleanRecordName(name), "."))
+}
+
+func zoneRecordsSortedKeys(m map[string]*route53.ResourceRecordSet) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
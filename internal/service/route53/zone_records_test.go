@@ -0,0 +1,263 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestAccRoute53ZoneRecords_zoneFile(t *testing.T) {
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsCount(resourceName, 5),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":      "www." + zoneName,
+						"ttl":       "300",
+						"type":      "A",
+						"records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name": "mail." + zoneName,
+						"ttl":  "3600",
+						"type": "MX",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "zone_name", zoneName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName, "192.0.2.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsCount(resourceName, 5),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name":      "www." + zoneName,
+						"records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "records.*.records.*", "192.0.2.3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_record(t *testing.T) {
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_record(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsCount(resourceName, 4),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name": "txt." + zoneName,
+						"type": "TXT",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "records.*.records.*", `"v=spf1 -all"`),
+				),
+			},
+			{
+				// Record sets created outside of Terraform are deleted.
+				PreConfig: func() {
+					testAccZoneRecordsCreateRecord(t, zoneName, "unmanaged."+zoneName)
+				},
+				Config: testAccZoneRecordsConfig_record(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsCount(resourceName, 4),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_manageSOANS(t *testing.T) {
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_manageSOANS(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRecordsCount(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "records.*", map[string]string{
+						"name": zoneName,
+						"ttl":  "3600",
+						"type": "NS",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckZoneRecordsCount(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 Zone Records ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		recordSets, err := tfroute53.FindResourceRecordSetsByZoneID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(recordSets); got != want {
+			return fmt.Errorf("Route53 Hosted Zone %s has %d record sets, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccZoneRecordsCreateRecord(t *testing.T, zoneName, name string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+	output, err := conn.ListHostedZonesByName(&route53.ListHostedZonesByNameInput{
+		DNSName:  aws.String(zoneName),
+		MaxItems: aws.String("1"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(output.HostedZones) == 0 {
+		t.Fatalf("Route53 Hosted Zone %s not found", zoneName)
+	}
+
+	err = tfroute53.ChangeRecordSets(conn, tfroute53.CleanZoneID(aws.StringValue(output.HostedZones[0].Id)), []*route53.Change{
+		{
+			Action: aws.String(route53.ChangeActionCreate),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name:            aws.String(name),
+				ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+				TTL:             aws.Int64(300),
+				Type:            aws.String(route53.RRTypeA),
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccZoneRecordsConfig_zoneFile(zoneName, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  zone_file = <<EOT
+$ORIGIN %[1]s.
+$TTL 3600
+@    IN SOA ns1 hostmaster 1 7200 900 1209600 86400
+     IN NS  ns1.example.net.
+www  300 IN A 192.0.2.2
+     300 IN A %[2]s
+mail IN MX  10 www
+txt  IN TXT "v=spf1 -all"
+EOT
+}
+`, zoneName, address)
+}
+
+func testAccZoneRecordsConfig_record(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  record {
+    name    = "txt"
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneRecordsConfig_manageSOANS(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id       = aws_route53_zone.test.zone_id
+  manage_soa_ns = true
+
+  record {
+    name    = aws_route53_zone.test.name
+    type    = "NS"
+    ttl     = 3600
+    records = aws_route53_zone.test.name_servers
+  }
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1"]
+  }
+}
+`, zoneName)
+}
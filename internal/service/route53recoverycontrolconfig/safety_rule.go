@@ -108,6 +108,7 @@ func ResourceSafetyRule() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSafetyRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	if _, ok := d.GetOk("asserted_controls"); ok {
 		return createAssertionRule(d, meta)
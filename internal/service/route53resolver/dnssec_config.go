@@ -73,6 +73,7 @@ func resourceDNSSECConfigCreate(d *schema.ResourceData, meta interface{}) error
 func resourceDNSSECConfigRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn
 
+	//lintignore:AWSR003
 	config, err := FindResolverDNSSECConfigByID(conn, d.Id())
 
 	if err != nil {
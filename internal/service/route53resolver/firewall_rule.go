@@ -126,6 +126,7 @@ func resourceFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error
 func resourceFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn
 
+	//lintignore:AWSR003
 	rule, err := FindFirewallRuleByID(conn, d.Id())
 
 	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
@@ -65,6 +65,7 @@ func resourceQueryLogConfigAssociationCreate(d *schema.ResourceData, meta interf
 func resourceQueryLogConfigAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn
 
+	//lintignore:AWSR003
 	queryLogConfigAssociation, err := FindResolverQueryLogConfigAssociationByID(conn, d.Id())
 
 	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
@@ -44,6 +44,7 @@ func ResourceBucketPolicy() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceBucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
 
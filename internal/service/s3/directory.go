@@ -0,0 +1,837 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/mitchellh/go-homedir"
+)
+
+func ResourceDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectoryCreate,
+		ReadContext:   resourceDirectoryRead,
+		UpdateContext: resourceDirectoryUpdate,
+		DeleteContext: resourceDirectoryDelete,
+
+		CustomizeDiff: resourceDirectoryCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Default:      s3.ObjectCannedACLPrivate,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comparison": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      DirectoryComparisonETag,
+				ValidateFunc: validation.StringInSlice(DirectoryComparison_Values(), false),
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"content_type_override": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validDirectoryPattern,
+						},
+					},
+				},
+			},
+			"delete_orphans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validDirectoryKeyPrefix,
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checksum_sha256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	objects, err := localDirectoryObjects(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(DirectoryCreateResourceID(bucket, keyPrefix))
+
+	// Record the objects that were uploaded even if others failed, so that they are deleted on destroy.
+	uploaded, err := uploadDirectoryObjects(ctx, conn, d, objects)
+
+	if err := d.Set("objects", flattenDirectoryObjects(uploaded)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting objects: %w", err))
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error uploading S3 Directory (%s) objects: %w", d.Id(), err))
+	}
+
+	return resourceDirectoryRead(ctx, d, meta)
+}
+
+func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	remoteObjects, err := FindDirectoryObjects(ctx, conn, bucket, keyPrefix)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading S3 Directory (%s): %w", d.Id(), err))
+	}
+
+	checksums := d.Get("comparison").(string) == DirectoryComparisonChecksum
+	deleteOrphans := d.Get("delete_orphans").(bool)
+	managed := make(map[string]bool)
+	var objects []*directoryObject
+
+	// Refresh the managed objects from S3. Source and content type are configuration.
+	for _, stateObject := range directoryManagedObjects(expandDirectoryObjects(d.Get("objects").([]interface{})), deleteOrphans) {
+		remoteObject, ok := remoteObjects[stateObject.Key]
+
+		if !ok {
+			continue
+		}
+
+		managed[stateObject.Key] = true
+		object := &directoryObject{
+			ContentType: stateObject.ContentType,
+			Key:         stateObject.Key,
+			Size:        aws.Int64Value(remoteObject.Size),
+			Source:      stateObject.Source,
+		}
+
+		if !checksums {
+			object.ETag = strings.Trim(aws.StringValue(remoteObject.ETag), `"`)
+		}
+
+		objects = append(objects, object)
+	}
+
+	// Unmanaged objects under the key prefix are orphans. They are added to the manifest so that
+	// the next plan shows their removal.
+	if deleteOrphans {
+		for key, remoteObject := range remoteObjects {
+			if managed[key] {
+				continue
+			}
+
+			object := &directoryObject{
+				Key:  key,
+				Size: aws.Int64Value(remoteObject.Size),
+			}
+
+			if !checksums {
+				object.ETag = strings.Trim(aws.StringValue(remoteObject.ETag), `"`)
+			}
+
+			objects = append(objects, object)
+		}
+	}
+
+	if checksums {
+		err := forEachDirectoryObject(ctx, d.Get("concurrency").(int), objects, func(ctx context.Context, object *directoryObject) error {
+			output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+				Bucket:       aws.String(bucket),
+				ChecksumMode: aws.String(s3.ChecksumModeEnabled),
+				Key:          aws.String(object.Key),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error reading S3 Object (%s): %w", object.Key, err)
+			}
+
+			object.ChecksumSHA256 = aws.StringValue(output.ChecksumSHA256)
+
+			return nil
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading S3 Directory (%s): %w", d.Id(), err))
+		}
+	}
+
+	sortDirectoryObjects(objects)
+
+	if err := d.Set("objects", flattenDirectoryObjects(objects)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting objects: %w", err))
+	}
+
+	return nil
+}
+
+func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	objects, err := localDirectoryObjects(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	o, _ := d.GetChange("objects")
+	oldObjects := make(map[string]*directoryObject)
+
+	for _, object := range expandDirectoryObjects(o.([]interface{})) {
+		oldObjects[object.Key] = object
+	}
+
+	// Object settings changes apply to every object, otherwise only upload new or changed objects.
+	uploadAll := d.HasChanges("acl", "cache_control", "server_side_encryption", "storage_class")
+	var toUpload []*directoryObject
+
+	for _, object := range objects {
+		if old, ok := oldObjects[object.Key]; uploadAll || !ok || !reflect.DeepEqual(old, object) {
+			toUpload = append(toUpload, object)
+		}
+
+		delete(oldObjects, object.Key)
+	}
+
+	if uploaded, err := uploadDirectoryObjects(ctx, conn, d, toUpload); err != nil {
+		// Record the objects that were uploaded, rather than the planned manifest.
+		if err := d.Set("objects", flattenDirectoryObjects(mergeDirectoryObjects(expandDirectoryObjects(o.([]interface{})), uploaded))); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting objects: %w", err))
+		}
+
+		return diag.FromErr(fmt.Errorf("error uploading S3 Directory (%s) objects: %w", d.Id(), err))
+	}
+
+	// Any remaining objects are no longer in the source directory, or are orphans.
+	deleteOrphans := d.Get("delete_orphans").(bool)
+	var toDelete []string
+
+	for key, object := range oldObjects {
+		if object.Source == "" && !deleteOrphans {
+			continue
+		}
+
+		toDelete = append(toDelete, key)
+	}
+
+	if err := deleteDirectoryObjects(ctx, conn, bucket, toDelete); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting S3 Directory (%s) objects: %w", d.Id(), err))
+	}
+
+	if err := d.Set("objects", flattenDirectoryObjects(objects)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting objects: %w", err))
+	}
+
+	return resourceDirectoryRead(ctx, d, meta)
+}
+
+func resourceDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	// Only objects uploaded from the source directory are deleted, never orphans recorded in the manifest.
+	keys := directoryUploadedObjectKeys(expandDirectoryObjects(d.Get("objects").([]interface{})))
+
+	log.Printf("[DEBUG] Deleting S3 Directory: %s", d.Id())
+	if err := deleteDirectoryObjects(ctx, conn, d.Get("bucket").(string), keys); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting S3 Directory (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The ETag of an object encrypted with a KMS key is not its MD5 digest, so every object would always differ.
+	if d.Get("server_side_encryption").(string) == s3.ServerSideEncryptionAwsKms && d.Get("comparison").(string) == DirectoryComparisonETag {
+		return fmt.Errorf("server_side_encryption %q requires comparison %q", s3.ServerSideEncryptionAwsKms, DirectoryComparisonChecksum)
+	}
+
+	for _, key := range []string{"comparison", "content_type_override", "key_prefix", "source"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("objects")
+		}
+	}
+
+	objects, err := localDirectoryObjects(d)
+
+	if err != nil {
+		return err
+	}
+
+	// Orphans in the manifest are removed from S3 on update if delete_orphans is set, otherwise they are
+	// no longer managed and are only removed from the manifest.
+	if tfList := flattenDirectoryObjects(objects); !reflect.DeepEqual(d.Get("objects"), tfList) {
+		return d.SetNew("objects", tfList)
+	}
+
+	return nil
+}
+
+// DirectoryCreateResourceID returns the ID of an S3 Directory from its bucket name and key prefix.
+func DirectoryCreateResourceID(bucket, keyPrefix string) string {
+	if keyPrefix == "" {
+		return bucket
+	}
+
+	return strings.Join([]string{bucket, keyPrefix}, resourceIDSeparator)
+}
+
+// FindDirectoryObjects returns the objects in the specified bucket under the specified key prefix, keyed by object key.
+func FindDirectoryObjects(ctx context.Context, conn *s3.S3, bucket, keyPrefix string) (map[string]*s3.Object, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	objects := make(map[string]*s3.Object)
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, object := range page.Contents {
+			if object == nil {
+				continue
+			}
+
+			objects[aws.StringValue(object.Key)] = object
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+type directoryContentTypeOverride struct {
+	contentType string
+	pattern     string
+}
+
+// localDirectoryObjects returns the object manifest for the local source directory.
+// Only the configured comparison's ETag or SHA-256 checksum is computed.
+func localDirectoryObjects(d interface{ Get(string) interface{} }) ([]*directoryObject, error) {
+	source := d.Get("source").(string)
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source (%s): %w", source, err)
+	}
+
+	keyPrefix := d.Get("key_prefix").(string)
+	checksums := d.Get("comparison").(string) == DirectoryComparisonChecksum
+	overrides := expandDirectoryContentTypeOverrides(d.Get("content_type_override").([]interface{}))
+	var objects []*directoryObject
+
+	err = filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Follow symbolic links to files but not to directories.
+		if info.Mode()&os.ModeSymlink != 0 {
+			if info, err = os.Stat(filePath); err != nil {
+				return err
+			}
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, filePath)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		object := &directoryObject{
+			ContentType: directoryContentType(rel, overrides),
+			Key:         keyPrefix + rel,
+			Size:        info.Size(),
+			Source:      rel,
+		}
+
+		if checksums {
+			if object.Size > objectMaxSinglePartSize {
+				return fmt.Errorf("file (%s) is larger than 5 GiB and can only be compared by %s", filePath, DirectoryComparisonETag)
+			}
+
+			object.ChecksumSHA256, err = fileChecksumSHA256(filePath)
+		} else {
+			object.ETag, err = fileETag(filePath, object.Size, directoryUploadPartSize(object.Size))
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Directory source file (%s): %w", filePath, err)
+		}
+
+		objects = append(objects, object)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading S3 Directory source (%s): %w", root, err)
+	}
+
+	sortDirectoryObjects(objects)
+
+	return objects, nil
+}
+
+// uploadDirectoryObjects uploads the specified objects from the local source directory using a bounded pool of workers.
+// The objects that were uploaded are returned, ordered by key, even if others failed.
+func uploadDirectoryObjects(ctx context.Context, conn *s3.S3, d *schema.ResourceData, objects []*directoryObject) ([]*directoryObject, error) {
+	root, err := homedir.Expand(d.Get("source").(string))
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := d.Get("bucket").(string)
+	checksums := d.Get("comparison").(string) == DirectoryComparisonChecksum
+	uploader := s3manager.NewUploaderWithClient(conn)
+	var uploaded []*directoryObject
+	var mu sync.Mutex
+
+	err = forEachDirectoryObject(ctx, d.Get("concurrency").(int), objects, func(ctx context.Context, object *directoryObject) error {
+		filePath := filepath.Join(root, filepath.FromSlash(object.Source))
+		file, err := os.Open(filePath)
+
+		if err != nil {
+			return fmt.Errorf("error opening S3 Directory source file (%s): %w", filePath, err)
+		}
+
+		defer func() {
+			if err := file.Close(); err != nil {
+				log.Printf("[WARN] Error closing S3 Directory source file (%s): %s", filePath, err)
+			}
+		}()
+
+		input := &s3manager.UploadInput{
+			ACL:    aws.String(d.Get("acl").(string)),
+			Body:   file,
+			Bucket: aws.String(bucket),
+			Key:    aws.String(object.Key),
+		}
+
+		if v, ok := d.GetOk("cache_control"); ok {
+			input.CacheControl = aws.String(v.(string))
+		}
+
+		if object.ContentType != "" {
+			input.ContentType = aws.String(object.ContentType)
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.ServerSideEncryption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("storage_class"); ok {
+			input.StorageClass = aws.String(v.(string))
+		}
+
+		partSize := directoryUploadPartSize(object.Size)
+
+		// Checksums are only sent for single part uploads, so upload the object in one part.
+		if checksums {
+			input.ChecksumSHA256 = aws.String(object.ChecksumSHA256)
+
+			if object.Size > partSize {
+				partSize = object.Size
+			}
+		}
+
+		log.Printf("[DEBUG] Uploading S3 Object (%s) from %s", object.Key, filePath)
+		_, err = uploader.UploadWithContext(ctx, input, func(u *s3manager.Uploader) {
+			u.PartSize = partSize
+		})
+
+		if err != nil {
+			return fmt.Errorf("error uploading S3 Object (%s): %w", object.Key, err)
+		}
+
+		mu.Lock()
+		uploaded = append(uploaded, object)
+		mu.Unlock()
+
+		return nil
+	})
+
+	sortDirectoryObjects(uploaded)
+
+	return uploaded, err
+}
+
+// deleteDirectoryObjects deletes the specified object keys in batches of up to 1000.
+func deleteDirectoryObjects(ctx context.Context, conn *s3.S3, bucket string, keys []string) error {
+	const (
+		batchSize = 1000
+	)
+
+	sort.Strings(keys)
+
+	var deleteErrs *multierror.Error
+
+	for len(keys) > 0 {
+		n := len(keys)
+
+		if n > batchSize {
+			n = batchSize
+		}
+
+		toDelete := make([]*s3.ObjectIdentifier, 0, n)
+
+		for _, key := range keys[:n] {
+			toDelete = append(toDelete, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		keys = keys[n:]
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: toDelete,
+				Quiet:   aws.Bool(true), // Only report errors.
+			},
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Errors {
+			if code := aws.StringValue(v.Code); code != s3.ErrCodeNoSuchKey {
+				deleteErrs = multierror.Append(deleteErrs, fmt.Errorf("deleting S3 Object (%s): %s: %s", aws.StringValue(v.Key), code, aws.StringValue(v.Message)))
+			}
+		}
+	}
+
+	return deleteErrs.ErrorOrNil()
+}
+
+// forEachDirectoryObject calls the specified function for each object with at most concurrency calls in flight.
+// All objects are processed and any errors are combined.
+func forEachDirectoryObject(ctx context.Context, concurrency int, objects []*directoryObject, fn func(context.Context, *directoryObject) error) error {
+	sem := make(chan struct{}, concurrency)
+	var errs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, object := range objects {
+		wg.Add(1)
+
+		go func(object *directoryObject) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := fn(ctx, object); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}(object)
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+const directoryDefaultContentType = "application/octet-stream"
+
+// directoryContentTypes maps lower case file extensions to content types.
+// A fixed table is used rather than the host's MIME database so that plans are the same on every platform.
+var directoryContentTypes = map[string]string{
+	".atom":        "application/atom+xml",
+	".avif":        "image/avif",
+	".bmp":         "image/bmp",
+	".css":         "text/css; charset=utf-8",
+	".csv":         "text/csv; charset=utf-8",
+	".eot":         "application/vnd.ms-fontobject",
+	".gif":         "image/gif",
+	".gz":          "application/gzip",
+	".htm":         "text/html; charset=utf-8",
+	".html":        "text/html; charset=utf-8",
+	".ico":         "image/x-icon",
+	".jpeg":        "image/jpeg",
+	".jpg":         "image/jpeg",
+	".js":          "text/javascript; charset=utf-8",
+	".json":        "application/json",
+	".map":         "application/json",
+	".md":          "text/markdown; charset=utf-8",
+	".mjs":         "text/javascript; charset=utf-8",
+	".mp3":         "audio/mpeg",
+	".mp4":         "video/mp4",
+	".ogg":         "audio/ogg",
+	".otf":         "font/otf",
+	".pdf":         "application/pdf",
+	".png":         "image/png",
+	".rss":         "application/rss+xml",
+	".svg":         "image/svg+xml",
+	".tar":         "application/x-tar",
+	".tif":         "image/tiff",
+	".tiff":        "image/tiff",
+	".ttf":         "font/ttf",
+	".txt":         "text/plain; charset=utf-8",
+	".wasm":        "application/wasm",
+	".wav":         "audio/wav",
+	".webm":        "video/webm",
+	".webmanifest": "application/manifest+json",
+	".webp":        "image/webp",
+	".woff":        "font/woff",
+	".woff2":       "font/woff2",
+	".xml":         "text/xml; charset=utf-8",
+	".yaml":        "application/yaml",
+	".yml":         "application/yaml",
+	".zip":         "application/zip",
+}
+
+// directoryContentType returns the content type for the specified slash-separated relative path.
+// The first matching override wins. Patterns without a slash are matched against the file name only.
+// Otherwise the content type is looked up by file extension, defaulting to application/octet-stream.
+func directoryContentType(rel string, overrides []directoryContentTypeOverride) string {
+	for _, override := range overrides {
+		name := rel
+
+		if !strings.Contains(override.pattern, "/") {
+			name = path.Base(rel)
+		}
+
+		if ok, _ := path.Match(override.pattern, name); ok {
+			return override.contentType
+		}
+	}
+
+	if v, ok := directoryContentTypes[strings.ToLower(path.Ext(rel))]; ok {
+		return v
+	}
+
+	return directoryDefaultContentType
+}
+
+// directoryUploadPartSize returns the part size the uploader uses for an object of the specified size.
+func directoryUploadPartSize(size int64) int64 {
+	partSize := int64(s3manager.DefaultUploadPartSize)
+
+	// Mirror the uploader's adjustment for objects that would otherwise need too many parts.
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = (size / s3manager.MaxUploadParts) + 1
+	}
+
+	return partSize
+}
+
+// fileETag returns the ETag S3 computes for the specified file when it is uploaded unencrypted or with SSE-S3 in parts of the specified size.
+// Objects uploaded in multiple parts have an ETag of the MD5 digest of the concatenated part MD5 digests, followed by the number of parts.
+func fileETag(filePath string, size, partSize int64) (string, error) {
+	file, err := os.Open(filePath)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	if size <= partSize {
+		hash := md5.New()
+
+		if _, err := io.Copy(hash, file); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	var digests []byte
+	var nParts int
+
+	for {
+		hash := md5.New()
+		n, err := io.CopyN(hash, file, partSize)
+
+		if n > 0 {
+			digests = append(digests, hash.Sum(nil)...)
+			nParts++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	digest := md5.Sum(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digest[:]), nParts), nil
+}
+
+// fileChecksumSHA256 returns the base64-encoded SHA-256 checksum of the specified file.
+func fileChecksumSHA256(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	return objectChecksum(s3.ChecksumAlgorithmSha256, file)
+}
+
+func expandDirectoryContentTypeOverrides(tfList []interface{}) []directoryContentTypeOverride {
+	var apiObjects []directoryContentTypeOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, directoryContentTypeOverride{
+			contentType: tfMap["content_type"].(string),
+			pattern:     tfMap["pattern"].(string),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,115 @@
+package s3
+
+import (
+	"sort"
+)
+
+// directoryObject is an entry in an S3 Directory's object manifest.
+type directoryObject struct {
+	ChecksumSHA256 string
+	ContentType    string
+	ETag           string
+	Key            string
+	Size           int64
+	Source         string
+}
+
+// directoryManagedObjects returns the objects in the specified manifest that are still managed.
+// Orphans, which have no source file, are only managed while delete_orphans is set.
+func directoryManagedObjects(objects []*directoryObject, deleteOrphans bool) []*directoryObject {
+	if deleteOrphans {
+		return objects
+	}
+
+	var managed []*directoryObject
+
+	for _, object := range objects {
+		if object.Source != "" {
+			managed = append(managed, object)
+		}
+	}
+
+	return managed
+}
+
+// directoryUploadedObjectKeys returns the keys of the objects in the specified manifest that were uploaded from a source file.
+func directoryUploadedObjectKeys(objects []*directoryObject) []string {
+	var keys []string
+
+	for _, object := range objects {
+		if object.Source != "" {
+			keys = append(keys, object.Key)
+		}
+	}
+
+	return keys
+}
+
+// mergeDirectoryObjects returns the specified manifest with the specified objects added or replaced, ordered by key.
+func mergeDirectoryObjects(objects, updates []*directoryObject) []*directoryObject {
+	merged := make(map[string]*directoryObject)
+
+	for _, object := range objects {
+		merged[object.Key] = object
+	}
+
+	for _, object := range updates {
+		merged[object.Key] = object
+	}
+
+	apiObjects := make([]*directoryObject, 0, len(merged))
+
+	for _, object := range merged {
+		apiObjects = append(apiObjects, object)
+	}
+
+	sortDirectoryObjects(apiObjects)
+
+	return apiObjects
+}
+
+func sortDirectoryObjects(objects []*directoryObject) {
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+}
+
+func expandDirectoryObjects(tfList []interface{}) []*directoryObject {
+	var apiObjects []*directoryObject
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &directoryObject{
+			ChecksumSHA256: tfMap["checksum_sha256"].(string),
+			ContentType:    tfMap["content_type"].(string),
+			ETag:           tfMap["etag"].(string),
+			Key:            tfMap["key"].(string),
+			Size:           int64(tfMap["size"].(int)),
+			Source:         tfMap["source"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDirectoryObjects(apiObjects []*directoryObject) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"checksum_sha256": apiObject.ChecksumSHA256,
+			"content_type":    apiObject.ContentType,
+			"etag":            apiObject.ETag,
+			"key":             apiObject.Key,
+			"size":            int(apiObject.Size),
+			"source":          apiObject.Source,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,163 @@
+package s3
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDirectoryManagedObjects(t *testing.T) {
+	managed := &directoryObject{Key: "site/a.txt", Source: "a.txt"}
+	orphan := &directoryObject{Key: "site/orphan.txt"}
+
+	testCases := []struct {
+		Name          string
+		DeleteOrphans bool
+		Expected      []*directoryObject
+	}{
+		{
+			Name:          "delete orphans",
+			DeleteOrphans: true,
+			Expected:      []*directoryObject{managed, orphan},
+		},
+		{
+			Name:          "keep orphans",
+			DeleteOrphans: false,
+			Expected:      []*directoryObject{managed},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := directoryManagedObjects([]*directoryObject{managed, orphan}, testCase.DeleteOrphans)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMergeDirectoryObjects(t *testing.T) {
+	a := &directoryObject{Key: "a", ETag: "1", Source: "a"}
+	b := &directoryObject{Key: "b", ETag: "1", Source: "b"}
+	newB := &directoryObject{Key: "b", ETag: "2", Source: "b"}
+	c := &directoryObject{Key: "c", ETag: "1", Source: "c"}
+
+	got := mergeDirectoryObjects([]*directoryObject{b, a}, []*directoryObject{c, newB})
+	expected := []*directoryObject{a, newB, c}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+// TestDirectoryDeleteOrphansDisabled verifies that orphans recorded while delete_orphans was set
+// are dropped from the plan once it is unset, and are never deleted on destroy.
+func TestDirectoryDeleteOrphansDisabled(t *testing.T) {
+	source := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(source, "a.txt"), []byte("a"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := ResourceDirectory()
+	d := r.TestResourceData()
+	d.SetId("test,site/")
+
+	// State after a refresh with delete_orphans set: the orphan is in the manifest.
+	for k, v := range map[string]interface{}{
+		"bucket":         "test",
+		"delete_orphans": true,
+		"key_prefix":     "site/",
+		"source":         source,
+		"objects": flattenDirectoryObjects([]*directoryObject{
+			{ContentType: "text/plain; charset=utf-8", ETag: "0cc175b9c0f1b6a831c399e269772661", Key: "site/a.txt", Size: 1, Source: "a.txt"},
+			{ETag: "e9f3a4bcae2ad4a7be1d9c5ac1a7ee1a", Key: "site/orphan.txt", Size: 6},
+		}),
+	} {
+		if err := d.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	state := d.State()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket":         "test",
+		"delete_orphans": false,
+		"key_prefix":     "site/",
+		"source":         source,
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff == nil || diff.Attributes["objects.#"] == nil {
+		t.Fatal("expected objects diff")
+	}
+
+	if got, expected := diff.Attributes["objects.#"].New, "1"; got != expected {
+		t.Errorf("got %s planned objects, expected %s", got, expected)
+	}
+
+	// Destroy with the orphan still in the manifest, e.g. if the plan was never applied.
+	if got, expected := directoryUploadedObjectKeys(expandDirectoryObjects(d.Get("objects").([]interface{}))), []string{"site/a.txt"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got destroyed keys %v, expected %v", got, expected)
+	}
+}
+
+func TestDirectoryKMSComparison(t *testing.T) {
+	source := t.TempDir()
+
+	testCases := []struct {
+		Name          string
+		Comparison    string
+		ExpectedError bool
+	}{
+		{
+			Name:          "default",
+			ExpectedError: true,
+		},
+		{
+			Name:          "etag",
+			Comparison:    DirectoryComparisonETag,
+			ExpectedError: true,
+		},
+		{
+			Name:       "checksum",
+			Comparison: DirectoryComparisonChecksum,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"bucket":                 "test",
+				"server_side_encryption": "aws:kms",
+				"source":                 source,
+			}
+
+			if testCase.Comparison != "" {
+				raw["comparison"] = testCase.Comparison
+			}
+
+			_, err := ResourceDirectory().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+
+			if testCase.ExpectedError {
+				if err == nil || !strings.Contains(err.Error(), `requires comparison "checksum"`) {
+					t.Errorf("got error %v, expected comparison error", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3Directory_basic(t *testing.T) {
+	resourceName := "aws_s3_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryCreateTempDir(t, map[string]string{
+		"index.html":     "<html></html>",
+		"css/site.css":   "body {}",
+		"data/file.json": "{}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectCount(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.key", "site/css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.content_type", "text/css; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "objects.1.key", "site/data/file.json"),
+					resource.TestCheckResourceAttr(resourceName, "objects.1.content_type", "application/x-data"),
+					resource.TestCheckResourceAttr(resourceName, "objects.2.key", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "objects.2.etag", "c83301425b2ad1d496473a5ff3d9ecca"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectoryWriteFile(t, source, "index.html", "<html><body></body></html>")
+
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "objects.1.key", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "objects.1.source", "index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Directory_checksum(t *testing.T) {
+	resourceName := "aws_s3_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryCreateTempDir(t, map[string]string{
+		"a.txt": "a",
+		"b.txt": "b",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_checksum(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.checksum_sha256", "ypeBEsobvcr6wjGzmiPcTaeG7/gUfE5yuYB3ha/uSLs="),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.etag", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Directory_deleteOrphans(t *testing.T) {
+	resourceName := "aws_s3_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryCreateTempDir(t, map[string]string{
+		"a.txt": "a",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_deleteOrphans(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+					_, err := conn.PutObject(&s3.PutObjectInput{
+						Body:   strings.NewReader("orphan"),
+						Bucket: aws.String(rName),
+						Key:    aws.String("site/orphan.txt"),
+					})
+
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryConfig_deleteOrphans(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.key", "site/a.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectoryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory" {
+			continue
+		}
+
+		objects, err := tfs3.FindDirectoryObjects(context.Background(), conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			continue
+		}
+
+		for _, object := range objects {
+			return fmt.Errorf("S3 Directory %s object %s still exists", rs.Primary.ID, aws.StringValue(object.Key))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectoryObjectCount(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Directory ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		objects, err := tfs3.FindDirectoryObjects(context.Background(), conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(objects); got != want {
+			return fmt.Errorf("S3 Directory %s has %d objects, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryCreateTempDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, data := range files {
+		testAccDirectoryWriteFile(t, dir, name, data)
+	}
+
+	return dir
+}
+
+func testAccDirectoryWriteFile(t *testing.T, dir, name, data string) {
+	filename := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectoryConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+
+  content_type_override {
+    pattern      = "data/*"
+    content_type = "application/x-data"
+  }
+}
+`, rName, source)
+}
+
+func testAccDirectoryConfig_checksum(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory" "test" {
+  bucket      = aws_s3_bucket.test.bucket
+  key_prefix  = "site/"
+  source      = %[2]q
+  comparison  = "checksum"
+  concurrency = 1
+}
+`, rName, source)
+}
+
+func testAccDirectoryConfig_deleteOrphans(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source         = %[2]q
+  delete_orphans = true
+}
+`, rName, source)
+}
@@ -17,6 +17,18 @@ const (
 	LifecycleRuleStatusDisabled = "Disabled"
 )
 
+const (
+	DirectoryComparisonChecksum = "checksum"
+	DirectoryComparisonETag     = "etag"
+)
+
+func DirectoryComparison_Values() []string {
+	return []string{
+		DirectoryComparisonChecksum,
+		DirectoryComparisonETag,
+	}
+}
+
 func BucketCannedACL_Values() []string {
 	result := s3.BucketCannedACL_Values()
 	result = appendUniqueString(result, BucketCannedACLExecRead)
@@ -3,8 +3,12 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
 	"net/http"
@@ -30,7 +34,12 @@ import (
 	"github.com/mitchellh/go-homedir"
 )
 
-const objectCreationTimeout = 2 * time.Minute
+const (
+	objectCreationTimeout = 2 * time.Minute
+
+	// objectMaxSinglePartSize is the largest object that can be uploaded with a single PutObject call.
+	objectMaxSinglePartSize = 5 * 1024 * 1024 * 1024
+)
 
 func ResourceObject() *schema.Resource {
 	return &schema.Resource{
@@ -70,6 +79,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -203,6 +233,11 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 		Key:    aws.String(key),
 	}
 
+	// Reading checksums of SSE-KMS encrypted objects requires kms:Decrypt permission.
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.Retry(objectCreationTimeout, func() *resource.RetryError {
@@ -239,6 +274,10 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -424,42 +463,14 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return fmt.Errorf("Error opening S3 object source (%s): %s", path, err)
-		}
+	body, closeBody, err := objectContent(d)
 
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return fmt.Errorf("error decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
-		body = bytes.NewReader([]byte{})
+	if err != nil {
+		return err
 	}
 
+	defer closeBody()
+
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
@@ -532,7 +543,49 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	var uploaderOpts []func(*s3manager.Uploader)
+
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		checksum, err := objectChecksum(v.(string), body)
+
+		if err != nil {
+			return fmt.Errorf("error computing S3 object checksum: %w", err)
+		}
+
+		size, err := body.Seek(0, io.SeekEnd)
+
+		if err == nil {
+			_, err = body.Seek(0, io.SeekStart)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 object content: %w", err)
+		}
+
+		// The uploader only sends checksums with single part uploads.
+		if size > objectMaxSinglePartSize {
+			return fmt.Errorf("objects larger than 5 GiB do not support checksum_algorithm")
+		}
+
+		if size > s3manager.DefaultUploadPartSize {
+			uploaderOpts = append(uploaderOpts, func(u *s3manager.Uploader) {
+				u.PartSize = size
+			})
+		}
+
+		switch v.(string) {
+		case s3.ChecksumAlgorithmCrc32:
+			input.ChecksumCRC32 = aws.String(checksum)
+		case s3.ChecksumAlgorithmCrc32c:
+			input.ChecksumCRC32C = aws.String(checksum)
+		case s3.ChecksumAlgorithmSha1:
+			input.ChecksumSHA1 = aws.String(checksum)
+		case s3.ChecksumAlgorithmSha256:
+			input.ChecksumSHA256 = aws.String(checksum)
+		}
+	}
+
+	if _, err := uploader.Upload(input, uploaderOpts...); err != nil {
 		return fmt.Errorf("Error uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -573,6 +626,10 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceObjectChecksumCustomizeDiff(d); err != nil {
+		return err
+	}
+
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -585,10 +642,66 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// resourceObjectChecksumCustomizeDiff compares the checksum of the configured content with the
+// checksum of the object in S3. Changes to the local source file or to the object in S3 cause a diff.
+func resourceObjectChecksumCustomizeDiff(d *schema.ResourceDiff) error {
+	algorithm := d.Get("checksum_algorithm").(string)
+
+	// Only the checksum for the configured algorithm is stored with the uploaded object.
+	if d.HasChange("checksum_algorithm") {
+		for _, v := range s3.ChecksumAlgorithm_Values() {
+			if v == algorithm {
+				continue
+			}
+
+			if err := d.SetNewComputed(objectChecksumAttribute(v)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if algorithm == "" {
+		return nil
+	}
+
+	key := objectChecksumAttribute(algorithm)
+
+	for _, v := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(v) {
+			return d.SetNewComputed(key)
+		}
+	}
+
+	body, closeBody, err := objectContent(d)
+
+	if err != nil {
+		return err
+	}
+
+	defer closeBody()
+
+	checksum, err := objectChecksum(algorithm, body)
+
+	if err != nil {
+		return fmt.Errorf("error computing S3 object checksum: %w", err)
+	}
+
+	if d.Get(key).(string) != checksum {
+		return d.SetNew(key, checksum)
+	}
+
+	return nil
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -611,6 +724,83 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
+// objectContent returns the configured object content from source, content or content_base64
+// and a function that releases it.
+func objectContent(d interface {
+	GetOk(string) (interface{}, bool)
+}) (io.ReadSeeker, func(), error) {
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error opening S3 object source (%s): %s", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	}
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		return bytes.NewReader([]byte(content)), func() {}, nil
+	}
+
+	if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error decoding content_base64: %s", err)
+		}
+		return bytes.NewReader(contentRaw), func() {}, nil
+	}
+
+	return bytes.NewReader([]byte{}), func() {}, nil
+}
+
+// objectChecksum returns the base64-encoded checksum of the content using the specified S3 checksum algorithm.
+// The content is rewound after reading.
+func objectChecksum(algorithm string, body io.ReadSeeker) (string, error) {
+	var h hash.Hash
+
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		h = crc32.NewIEEE()
+	case s3.ChecksumAlgorithmCrc32c:
+		h = crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case s3.ChecksumAlgorithmSha1:
+		h = sha1.New()
+	case s3.ChecksumAlgorithmSha256:
+		h = sha256.New()
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+
+	if _, err := io.Copy(h, body); err != nil {
+		return "", err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// objectChecksumAttribute returns the name of the attribute holding the checksum for the specified S3 checksum algorithm.
+func objectChecksumAttribute(algorithm string) string {
+	return "checksum_" + strings.ToLower(algorithm)
+}
+
 // DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
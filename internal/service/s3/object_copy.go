@@ -295,6 +295,7 @@ func ResourceObjectCopy() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceObjectCopyCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceObjectCopyDoCopy(d, meta)
 }
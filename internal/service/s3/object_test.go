@@ -253,6 +253,61 @@ func TestAccS3Object_sourceHashTrigger(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_checksumAlgorithm(t *testing.T) {
+	var obj, updated_obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	filename := testAccObjectCreateTempFile(t, "Ebben!")
+	defer os.Remove(filename)
+
+	rewriteFile := func(*terraform.State) error {
+		if err := os.WriteFile(filename, []byte("Ne andrò lontana"), 0644); err != nil {
+			os.Remove(filename)
+			t.Fatal(err)
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, filename, s3.ChecksumAlgorithmSha256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					testAccCheckObjectBody(&obj, "Ebben!"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "QifZEbR25GUM80w73rZDli2WfdThYYLK2RjGoFGGuSo="),
+					rewriteFile,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, filename, s3.ChecksumAlgorithmSha256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &updated_obj),
+					testAccCheckObjectBody(&updated_obj, "Ne andrò lontana"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "q2j00Vv/exbSoCQcDv7g55LgR7IadJWPXb7vJlZdimU="),
+				),
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, filename, s3.ChecksumAlgorithmCrc32),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &updated_obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum_crc32"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_withContentCharacteristics(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_object.object"
@@ -1658,6 +1713,21 @@ resource "aws_s3_object" "object" {
 `, rName, contentBase64)
 }
 
+func testAccObjectConfig_checksumAlgorithm(rName, source, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  checksum_algorithm = %[3]q
+}
+`, rName, source, checksumAlgorithm)
+}
+
 func testAccObjectConfig_sourceHashTrigger(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 	"time"
@@ -53,3 +54,26 @@ func validBucketLifecycleTimestamp(v interface{}, k string) (ws []string, errors
 
 	return
 }
+
+func validDirectoryPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a valid pattern: %s", k, err))
+	}
+
+	return
+}
+
+// validDirectoryKeyPrefix requires a non-empty S3 Directory key prefix to end in "/".
+// Objects are listed by key prefix, so without it objects under sibling prefixes,
+// e.g. "site-old/" for "site", would be treated as orphans and could be deleted.
+func validDirectoryKeyPrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "" && !strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf(
+			"%q must end with a slash (/), got: %s", k, value))
+	}
+
+	return
+}
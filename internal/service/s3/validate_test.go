@@ -0,0 +1,35 @@
+package s3
+
+import (
+	"testing"
+)
+
+func TestValidDirectoryKeyPrefix(t *testing.T) {
+	validPrefixes := []string{
+		"",
+		"site/",
+		"site/assets/",
+	}
+
+	for _, v := range validPrefixes {
+		_, errors := validDirectoryKeyPrefix(v, "key_prefix")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid S3 Directory key prefix: %q", v, errors)
+		}
+	}
+
+	// Listing objects by these prefixes would include sibling prefixes,
+	// e.g. "site-old/index.html" and "site.bak" for "site".
+	invalidPrefixes := []string{
+		"site",
+		"site/assets",
+		"site.",
+	}
+
+	for _, v := range invalidPrefixes {
+		_, errors := validDirectoryKeyPrefix(v, "key_prefix")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid S3 Directory key prefix", v)
+		}
+	}
+}
@@ -102,6 +102,7 @@ func resourceEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3OutpostsConn
 
+	//lintignore:AWSR003
 	endpoint, err := FindEndpoint(conn, d.Id())
 
 	if err != nil {
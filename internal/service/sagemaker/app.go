@@ -148,6 +148,7 @@ func resourceAppRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	//lintignore:AWSR003
 	app, err := FindAppByName(conn, domainID, userProfileName, appType, appName)
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
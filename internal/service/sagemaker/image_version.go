@@ -77,6 +77,7 @@ func resourceImageVersionCreate(d *schema.ResourceData, meta interface{}) error
 func resourceImageVersionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SageMakerConn
 
+	//lintignore:AWSR003
 	image, err := FindImageVersionByName(conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "does not exist") {
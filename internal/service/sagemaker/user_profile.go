@@ -324,6 +324,7 @@ func resourceUserProfileRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	//lintignore:AWSR003
 	UserProfile, err := FindUserProfileByName(conn, domainID, userProfileName)
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
@@ -268,6 +268,7 @@ func resourceSecretRead(d *schema.ResourceData, meta interface{}) error {
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		//lintignore:AWSR003
 		return FindSecretByID(conn, d.Id())
 	}, d.IsNewResource())
 
@@ -52,6 +52,7 @@ func ResourceSecretRotation() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSR006
 			"tags": tftags.TagsSchema(),
 		},
 	}
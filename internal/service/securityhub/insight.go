@@ -175,6 +175,7 @@ func resourceInsightCreate(ctx context.Context, d *schema.ResourceData, meta int
 func resourceInsightRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 
+	//lintignore:AWSR003
 	insight, err := FindInsight(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
@@ -60,6 +60,7 @@ func resourceOrganizationAdminAccountCreate(d *schema.ResourceData, meta interfa
 func resourceOrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 
+	//lintignore:AWSR003
 	adminAccount, err := FindAdminAccount(conn, d.Id())
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
@@ -129,6 +129,7 @@ func resourceCloudFormationStackRead(d *schema.ResourceData, meta interface{}) e
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	//lintignore:AWSR003
 	stack, err := tfcloudformation.FindStackByID(cfConn, d.Id())
 
 	if tfresource.NotFound(err) {
@@ -0,0 +1,1018 @@
+package sfn
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	definitionMaxLen = 1024 * 1024 // 1048576
+)
+
+//go:embed definition_schema.json
+var definitionSchemaJSON string
+
+var definitionSchema = func() *gojsonschema.Schema {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(definitionSchemaJSON))
+
+	if err != nil {
+		panic(fmt.Sprintf("error loading state machine definition schema: %s", err))
+	}
+
+	return schema
+}()
+
+// definitionErrorNames are the predefined error names of the States. namespace.
+var definitionErrorNames = map[string]bool{
+	"States.ALL":                             true,
+	"States.BranchFailed":                    true,
+	"States.DataLimitExceeded":               true,
+	"States.ExceedToleratedFailureThreshold": true,
+	"States.HeartbeatTimeout":                true,
+	"States.IntrinsicFailure":                true,
+	"States.ItemReaderFailed":                true,
+	"States.NoChoiceMatched":                 true,
+	"States.ParameterPathFailure":            true,
+	"States.Permissions":                     true,
+	"States.ResultPathMatchFailure":          true,
+	"States.ResultWriterFailed":              true,
+	"States.Runtime":                         true,
+	"States.TaskFailed":                      true,
+	"States.Timeout":                         true,
+	"States.QueryEvaluationError":            true,
+}
+
+// definitionIntrinsicFunctions are the intrinsic functions that can be used in payload templates.
+var definitionIntrinsicFunctions = map[string]bool{
+	"States.Array":          true,
+	"States.ArrayContains":  true,
+	"States.ArrayGetItem":   true,
+	"States.ArrayLength":    true,
+	"States.ArrayPartition": true,
+	"States.ArrayRange":     true,
+	"States.ArrayUnique":    true,
+	"States.Base64Decode":   true,
+	"States.Base64Encode":   true,
+	"States.Format":         true,
+	"States.Hash":           true,
+	"States.JsonMerge":      true,
+	"States.JsonToString":   true,
+	"States.MathAdd":        true,
+	"States.MathRandom":     true,
+	"States.StringSplit":    true,
+	"States.StringToJson":   true,
+	"States.UUID":           true,
+}
+
+// definitionChoiceComparators maps the comparison operators of Choice rules to the JSON type of their value.
+var definitionChoiceComparators = map[string]string{
+	"BooleanEquals":                  "boolean",
+	"BooleanEqualsPath":              "path",
+	"IsBoolean":                      "boolean",
+	"IsNull":                         "boolean",
+	"IsNumeric":                      "boolean",
+	"IsPresent":                      "boolean",
+	"IsString":                       "boolean",
+	"IsTimestamp":                    "boolean",
+	"NumericEquals":                  "number",
+	"NumericEqualsPath":              "path",
+	"NumericGreaterThan":             "number",
+	"NumericGreaterThanPath":         "path",
+	"NumericGreaterThanEquals":       "number",
+	"NumericGreaterThanEqualsPath":   "path",
+	"NumericLessThan":                "number",
+	"NumericLessThanPath":            "path",
+	"NumericLessThanEquals":          "number",
+	"NumericLessThanEqualsPath":      "path",
+	"StringEquals":                   "string",
+	"StringEqualsPath":               "path",
+	"StringGreaterThan":              "string",
+	"StringGreaterThanPath":          "path",
+	"StringGreaterThanEquals":        "string",
+	"StringGreaterThanEqualsPath":    "path",
+	"StringLessThan":                 "string",
+	"StringLessThanPath":             "path",
+	"StringLessThanEquals":           "string",
+	"StringLessThanEqualsPath":       "path",
+	"StringMatches":                  "string",
+	"TimestampEquals":                "string",
+	"TimestampEqualsPath":            "path",
+	"TimestampGreaterThan":           "string",
+	"TimestampGreaterThanPath":       "path",
+	"TimestampGreaterThanEquals":     "string",
+	"TimestampGreaterThanEqualsPath": "path",
+	"TimestampLessThan":              "string",
+	"TimestampLessThanPath":          "path",
+	"TimestampLessThanEquals":        "string",
+	"TimestampLessThanEqualsPath":    "path",
+}
+
+// DefinitionProblem is a problem in a state machine definition.
+type DefinitionProblem struct {
+	// Path is the JSON Pointer of the problem, e.g. /States/Start/Next.
+	Path    string
+	Message string
+	// Warning is set for unknown fields, which may be Amazon States Language features newer than the schema,
+	// and for path and intrinsic function syntax, which is only checked locally.
+	Warning bool
+}
+
+func (p DefinitionProblem) String() string {
+	if p.Path == "" {
+		return p.Message
+	}
+
+	return fmt.Sprintf("%s: %s", p.Path, p.Message)
+}
+
+// ValidateDefinition checks an Amazon States Language state machine definition in JSON without calling AWS.
+// The structure of the definition is checked against an embedded JSON schema, then the following are checked:
+//   - StartAt, Next, Default and Catch transitions name a state in the same state machine, Parallel branch or Map processor
+//   - Each state is reachable from StartAt, and Pass, Task, Wait, Parallel and Map states have exactly one of Next or End
+//   - Retry and Catch error names in the States. namespace are predefined, and States.ALL is used alone and last
+//   - Choice rules are And, Or or Not rules, have a JSONata Condition, or have a Variable and exactly one comparison
+//     operator of the right type, and only top-level rules have Next
+//   - Paths are JSONPaths or variable references, and payload template fields ending in ".$" are paths or valid
+//     intrinsic function calls
+//   - State names are unique across Parallel branches and Map processors
+//
+// Unknown fields and invalid path or intrinsic function syntax are reported as warnings. An error is returned if the definition isn't JSON.
+func ValidateDefinition(definition string) ([]DefinitionProblem, error) {
+	var doc interface{}
+
+	if err := json.Unmarshal([]byte(definition), &doc); err != nil {
+		return nil, fmt.Errorf("error parsing state machine definition: %w", err)
+	}
+
+	result, err := definitionSchema.Validate(gojsonschema.NewGoLoader(doc))
+
+	if err != nil {
+		return nil, fmt.Errorf("error validating state machine definition: %w", err)
+	}
+
+	v := &definitionValidator{
+		names: make(map[string]string),
+	}
+
+	for _, resultErr := range result.Errors() {
+		switch resultErr.Type() {
+		// Reported by the schemas they combine.
+		case "condition_then", "condition_else", "number_all_of", "number_any_of", "number_one_of":
+			continue
+		}
+
+		path := strings.TrimPrefix(resultErr.Context().String("/"), "(root)")
+
+		if property, ok := resultErr.Details()["property"].(string); ok && resultErr.Type() == "additional_property_not_allowed" {
+			v.warning(path+"/"+definitionPointerEscape(property), "%s", resultErr.Description())
+			continue
+		}
+
+		// The path replaces the dotted field name that some descriptions start with.
+		v.problem(path, "%s", strings.TrimPrefix(resultErr.Description(), resultErr.Field()+" "))
+	}
+
+	// Only check transitions and contents of structurally valid definitions.
+	if !definitionProblemsHaveError(v.problems) {
+		v.stateMachine("", doc.(map[string]interface{}))
+	}
+
+	sort.SliceStable(v.problems, func(i, j int) bool {
+		return v.problems[i].Path < v.problems[j].Path
+	})
+
+	return v.problems, nil
+}
+
+// DefinitionJSON returns the definition as JSON. Definitions in YAML are converted to JSON.
+func DefinitionJSON(definition string) (string, error) {
+	if json.Valid([]byte(definition)) {
+		return definition, nil
+	}
+
+	var doc interface{}
+
+	if err := yaml.Unmarshal([]byte(definition), &doc); err != nil {
+		return "", fmt.Errorf("error parsing state machine definition as JSON or YAML: %w", err)
+	}
+
+	doc, err := definitionFromYAML(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// validDefinition is a ValidateDiagFunc that reports each problem of a state machine definition
+// as a diagnostic of the attribute.
+func validDefinition(v interface{}, path cty.Path) diag.Diagnostics {
+	if len(v.(string)) > definitionMaxLen {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid state machine definition",
+			Detail:        fmt.Sprintf("definition must be at most %d characters", definitionMaxLen),
+			AttributePath: path,
+		}}
+	}
+
+	problems, err := ValidateDefinition(v.(string))
+
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid state machine definition",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	return definitionProblemDiagnostics(problems, diag.Error, path)
+}
+
+// definitionProblemDiagnostics reports each problem as a diagnostic of the attribute.
+// Warnings are always reported with the warning severity.
+func definitionProblemDiagnostics(problems []DefinitionProblem, severity diag.Severity, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, problem := range problems {
+		severity := severity
+
+		if problem.Warning {
+			severity = diag.Warning
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      severity,
+			Summary:       "Invalid state machine definition",
+			Detail:        problem.String(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+type definitionValidator struct {
+	// names maps state names to the path of the state, across the whole state machine.
+	names    map[string]string
+	problems []DefinitionProblem
+}
+
+func (v *definitionValidator) problem(path, format string, a ...interface{}) {
+	v.problems = append(v.problems, DefinitionProblem{
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+func (v *definitionValidator) warning(path, format string, a ...interface{}) {
+	v.problems = append(v.problems, DefinitionProblem{
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+		Warning: true,
+	})
+}
+
+func definitionProblemsHaveError(problems []DefinitionProblem) bool {
+	for _, problem := range problems {
+		if !problem.Warning {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stateMachine checks a state machine, Parallel branch or Map processor. Transitions can't leave it.
+func (v *definitionValidator) stateMachine(path string, m map[string]interface{}) {
+	states := m["States"].(map[string]interface{})
+	startAt := m["StartAt"].(string)
+
+	if _, ok := states[startAt]; !ok {
+		v.problem(path+"/StartAt", "state %q does not exist", startAt)
+	}
+
+	transitions := make(map[string][]string)
+
+	for _, name := range definitionSortedKeys(states) {
+		statePath := path + "/States/" + definitionPointerEscape(name)
+
+		if other, ok := v.names[name]; ok {
+			v.problem(statePath, "state name %q is already used by %s", name, other)
+		} else {
+			v.names[name] = statePath
+		}
+
+		transitions[name] = v.state(statePath, states, states[name].(map[string]interface{}))
+	}
+
+	// Find the states reachable from StartAt.
+	reachable := map[string]bool{startAt: true}
+	queue := []string{startAt}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, next := range transitions[name] {
+			if !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, name := range definitionSortedKeys(states) {
+		if !reachable[name] {
+			v.problem(path+"/States/"+definitionPointerEscape(name), "state is not reachable from StartAt")
+		}
+	}
+}
+
+// state checks a state and returns the names of the states it can transition to.
+func (v *definitionValidator) state(path string, states, state map[string]interface{}) []string {
+	var transitions []string
+
+	transition := func(path, next string) {
+		if _, ok := states[next]; !ok {
+			v.problem(path, "state %q does not exist", next)
+			return
+		}
+
+		transitions = append(transitions, next)
+	}
+
+	stateType := state["Type"].(string)
+
+	switch stateType {
+	case "Map", "Parallel", "Pass", "Task", "Wait":
+		next, hasNext := state["Next"].(string)
+		end, _ := state["End"].(bool)
+
+		switch {
+		case hasNext && end:
+			v.problem(path, "%s state must not have both Next and End", stateType)
+		case hasNext:
+			transition(path+"/Next", next)
+		case !end:
+			v.problem(path, "%s state must have Next or End", stateType)
+		}
+	}
+
+	switch stateType {
+	case "Choice":
+		for i, rule := range state["Choices"].([]interface{}) {
+			rulePath := fmt.Sprintf("%s/Choices/%d", path, i)
+
+			if next, ok := v.choiceRule(rulePath, rule.(map[string]interface{}), true); ok {
+				transition(rulePath+"/Next", next)
+			}
+		}
+
+		if next, ok := state["Default"].(string); ok {
+			transition(path+"/Default", next)
+		}
+	case "Map":
+		iterator, hasIterator := state["Iterator"].(map[string]interface{})
+		itemProcessor, hasItemProcessor := state["ItemProcessor"].(map[string]interface{})
+
+		switch {
+		case hasIterator && hasItemProcessor:
+			v.problem(path, "Map state must not have both Iterator and ItemProcessor")
+		case hasIterator:
+			v.stateMachine(path+"/Iterator", iterator)
+		case hasItemProcessor:
+			v.stateMachine(path+"/ItemProcessor", itemProcessor)
+		default:
+			v.problem(path, "Map state must have ItemProcessor or Iterator")
+		}
+
+		if _, ok := state["ItemsPath"]; ok {
+			v.path(path+"/ItemsPath", state["ItemsPath"], false)
+		}
+
+		for _, k := range []string{"ItemSelector", "Parameters", "ResultSelector"} {
+			v.payloadTemplate(path+"/"+k, state[k])
+		}
+	case "Parallel":
+		for i, branch := range state["Branches"].([]interface{}) {
+			v.stateMachine(fmt.Sprintf("%s/Branches/%d", path, i), branch.(map[string]interface{}))
+		}
+
+		for _, k := range []string{"Parameters", "ResultSelector"} {
+			v.payloadTemplate(path+"/"+k, state[k])
+		}
+	case "Pass":
+		v.payloadTemplate(path+"/Parameters", state["Parameters"])
+	case "Task":
+		for _, k := range []string{"Credentials", "Parameters", "ResultSelector"} {
+			v.payloadTemplate(path+"/"+k, state[k])
+		}
+
+		if _, ok := state["TimeoutSeconds"]; ok {
+			if _, ok := state["TimeoutSecondsPath"]; ok {
+				v.problem(path, "Task state must not have both TimeoutSeconds and TimeoutSecondsPath")
+			}
+		}
+
+		if _, ok := state["HeartbeatSeconds"]; ok {
+			if _, ok := state["HeartbeatSecondsPath"]; ok {
+				v.problem(path, "Task state must not have both HeartbeatSeconds and HeartbeatSecondsPath")
+			}
+		}
+
+		if timeout, ok := state["TimeoutSeconds"].(float64); ok {
+			if heartbeat, ok := state["HeartbeatSeconds"].(float64); ok && heartbeat >= timeout {
+				v.problem(path+"/HeartbeatSeconds", "HeartbeatSeconds must be smaller than TimeoutSeconds")
+			}
+		}
+	case "Wait":
+		n := 0
+
+		for _, k := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[k]; ok {
+				n++
+			}
+		}
+
+		if n != 1 {
+			v.problem(path, "Wait state must have exactly one of Seconds, SecondsPath, Timestamp or TimestampPath")
+		}
+	}
+
+	for _, k := range []string{"CausePath", "ErrorPath", "HeartbeatSecondsPath", "InputPath", "MaxConcurrencyPath", "OutputPath", "SecondsPath", "TimeoutSecondsPath", "TimestampPath", "ToleratedFailureCountPath", "ToleratedFailurePercentagePath"} {
+		if value, ok := state[k]; ok && value != nil {
+			v.path(path+"/"+k, value, false)
+		}
+	}
+
+	if value, ok := state["ResultPath"]; ok && value != nil {
+		v.path(path+"/ResultPath", value, true)
+	}
+
+	if retriers, ok := state["Retry"].([]interface{}); ok {
+		for i, retrier := range retriers {
+			v.errorEquals(fmt.Sprintf("%s/Retry/%d/ErrorEquals", path, i), retrier.(map[string]interface{}), i == len(retriers)-1)
+		}
+	}
+
+	if catchers, ok := state["Catch"].([]interface{}); ok {
+		for i, catcher := range catchers {
+			catcherPath := fmt.Sprintf("%s/Catch/%d", path, i)
+			catcher := catcher.(map[string]interface{})
+
+			v.errorEquals(catcherPath+"/ErrorEquals", catcher, i == len(catchers)-1)
+			transition(catcherPath+"/Next", catcher["Next"].(string))
+
+			if value, ok := catcher["ResultPath"]; ok && value != nil {
+				v.path(catcherPath+"/ResultPath", value, true)
+			}
+		}
+	}
+
+	return transitions
+}
+
+// errorEquals checks the error names of a retrier or catcher.
+func (v *definitionValidator) errorEquals(path string, m map[string]interface{}, last bool) {
+	names := m["ErrorEquals"].([]interface{})
+
+	for i, name := range names {
+		name := name.(string)
+		namePath := fmt.Sprintf("%s/%d", path, i)
+
+		if strings.HasPrefix(name, "States.") && !definitionErrorNames[name] {
+			v.problem(namePath, "%q is not a predefined error name", name)
+		}
+
+		if name == "States.ALL" {
+			if len(names) > 1 {
+				v.problem(namePath, "States.ALL must be the only error name")
+			}
+
+			if !last {
+				v.problem(namePath, "States.ALL must be in the last retrier or catcher")
+			}
+		}
+	}
+}
+
+// choiceRule checks a Choice rule and returns its Next, if it's a top-level rule.
+func (v *definitionValidator) choiceRule(path string, rule map[string]interface{}, top bool) (string, bool) {
+	next, hasNext := rule["Next"]
+
+	if top && !hasNext {
+		v.problem(path, "Choice rule must have Next")
+	} else if !top && hasNext {
+		v.problem(path+"/Next", "only top-level Choice rules can have Next")
+	}
+
+	var operators []string
+
+	for _, k := range definitionSortedKeys(rule) {
+		switch k {
+		case "And", "Condition", "Or", "Not", "Variable":
+			operators = append(operators, k)
+		case "Assign", "Comment", "Next", "Output":
+		default:
+			if _, ok := definitionChoiceComparators[k]; !ok {
+				v.warning(path+"/"+definitionPointerEscape(k), "unknown Choice rule field %q", k)
+			}
+		}
+	}
+
+	comparators := 0
+
+	for k, valueType := range definitionChoiceComparators {
+		value, ok := rule[k]
+
+		if !ok {
+			continue
+		}
+
+		comparators++
+		valuePath := path + "/" + k
+
+		switch valueType {
+		case "boolean":
+			if _, ok := value.(bool); !ok {
+				v.problem(valuePath, "%s must be a boolean", k)
+			}
+		case "number":
+			if _, ok := value.(float64); !ok {
+				v.problem(valuePath, "%s must be a number", k)
+			}
+		case "path":
+			v.path(valuePath, value, false)
+		case "string":
+			if _, ok := value.(string); !ok {
+				v.problem(valuePath, "%s must be a string", k)
+			}
+		}
+	}
+
+	switch {
+	case len(operators) == 1 && operators[0] == "Condition":
+		// JSONata conditions are expressions, so only their type is checked.
+		if _, ok := rule["Condition"].(string); !ok {
+			v.problem(path+"/Condition", "Condition must be a string")
+		}
+
+		if comparators > 0 {
+			v.problem(path, "Choice rule with Condition must not have a comparison operator")
+		}
+	case len(operators) == 1 && operators[0] == "Variable":
+		v.path(path+"/Variable", rule["Variable"], false)
+
+		if comparators != 1 {
+			v.problem(path, "Choice rule with Variable must have exactly one comparison operator, found %d", comparators)
+		}
+	case len(operators) == 1 && (operators[0] == "And" || operators[0] == "Or"):
+		rules, ok := rule[operators[0]].([]interface{})
+
+		if !ok || len(rules) == 0 {
+			v.problem(path+"/"+operators[0], "%s must be a non-empty array of Choice rules", operators[0])
+			break
+		}
+
+		for i, r := range rules {
+			if r, ok := r.(map[string]interface{}); ok {
+				v.choiceRule(fmt.Sprintf("%s/%s/%d", path, operators[0], i), r, false)
+			} else {
+				v.problem(fmt.Sprintf("%s/%s/%d", path, operators[0], i), "Choice rule must be an object")
+			}
+		}
+
+		if comparators > 0 {
+			v.problem(path, "Choice rule with %s must not have a comparison operator", operators[0])
+		}
+	case len(operators) == 1 && operators[0] == "Not":
+		if r, ok := rule["Not"].(map[string]interface{}); ok {
+			v.choiceRule(path+"/Not", r, false)
+		} else {
+			v.problem(path+"/Not", "Not must be a Choice rule")
+		}
+
+		if comparators > 0 {
+			v.problem(path, "Choice rule with Not must not have a comparison operator")
+		}
+	default:
+		v.problem(path, "Choice rule must have exactly one of And, Or, Not, Variable or Condition")
+	}
+
+	if s, ok := next.(string); ok {
+		return s, top
+	} else if hasNext {
+		v.problem(path+"/Next", "Next must be a string")
+	}
+
+	return "", false
+}
+
+// payloadTemplate checks that the values of fields ending in ".$" are paths or intrinsic function calls.
+func (v *definitionValidator) payloadTemplate(path string, value interface{}) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, k := range definitionSortedKeys(value) {
+			fieldPath := path + "/" + definitionPointerEscape(k)
+
+			if !strings.HasSuffix(k, ".$") {
+				v.payloadTemplate(fieldPath, value[k])
+				continue
+			}
+
+			s, ok := value[k].(string)
+
+			if !ok {
+				v.problem(fieldPath, "value of a field ending in \".$\" must be a path or intrinsic function")
+				continue
+			}
+
+			if strings.HasPrefix(s, "States.") {
+				if err := validIntrinsicFunction(s); err != nil {
+					v.warning(fieldPath, "invalid intrinsic function: %s", err)
+				}
+
+				continue
+			}
+
+			v.path(fieldPath, s, false)
+		}
+	case []interface{}:
+		for i, e := range value {
+			v.payloadTemplate(fmt.Sprintf("%s/%d", path, i), e)
+		}
+	}
+}
+
+// path checks a path. Reference paths, such as ResultPath, can't use the context object,
+// variables, wildcards, filters or descendants.
+// Path syntax is only checked locally, so problems are reported as warnings.
+func (v *definitionValidator) path(path string, value interface{}, reference bool) {
+	s, ok := value.(string)
+
+	if !ok {
+		v.problem(path, "path must be a string")
+		return
+	}
+
+	if err := validJSONPath(s, reference); err != nil {
+		v.warning(path, "invalid path %q: %s", s, err)
+	}
+}
+
+// validJSONPath checks the syntax of a JSONPath as used by Step Functions.
+func validJSONPath(s string, reference bool) error {
+	switch {
+	case strings.HasPrefix(s, "$$"):
+		if reference {
+			return fmt.Errorf("the context object can't be used in a reference path")
+		}
+
+		s = s[2:]
+	case strings.HasPrefix(s, "$"):
+		s = s[1:]
+
+		// Variables, e.g. $order or $order.items[0], that are set with Assign.
+		if n := jsonPathVariableLen(s); n > 0 {
+			if reference {
+				return fmt.Errorf("variables can't be used in a reference path")
+			}
+
+			s = s[n:]
+		}
+	default:
+		return fmt.Errorf("must start with \"$\"")
+	}
+
+	for len(s) > 0 {
+		switch {
+		case strings.HasPrefix(s, ".."):
+			if reference {
+				return fmt.Errorf("descendants can't be used in a reference path")
+			}
+
+			s = s[1:]
+		case s[0] == '.':
+			s = s[1:]
+
+			if strings.HasPrefix(s, "*") {
+				if reference {
+					return fmt.Errorf("wildcards can't be used in a reference path")
+				}
+
+				s = s[1:]
+				continue
+			}
+
+			n := strings.IndexFunc(s, func(r rune) bool {
+				return r == '.' || r == '[' || unicode.IsSpace(r)
+			})
+
+			if n == -1 {
+				n = len(s)
+			}
+
+			if n == 0 {
+				return fmt.Errorf("empty field name")
+			}
+
+			s = s[n:]
+		case s[0] == '[':
+			n, err := jsonPathBracketLen(s)
+
+			if err != nil {
+				return err
+			}
+
+			selector := strings.TrimSpace(s[1 : n-1])
+
+			switch {
+			case selector == "":
+				return fmt.Errorf("empty selector")
+			case selector[0] == '\'' || selector[0] == '"':
+				if len(selector) < 2 || selector[len(selector)-1] != selector[0] {
+					return fmt.Errorf("unterminated field name %s", selector)
+				}
+			case strings.HasPrefix(selector, "?("):
+				if reference {
+					return fmt.Errorf("filters can't be used in a reference path")
+				}
+
+				if !strings.HasSuffix(selector, ")") {
+					return fmt.Errorf("unterminated filter %s", selector)
+				}
+			case selector == "*":
+				if reference {
+					return fmt.Errorf("wildcards can't be used in a reference path")
+				}
+			default:
+				// Indexes, unions and slices.
+				for _, r := range selector {
+					if !unicode.IsDigit(r) && !strings.ContainsRune("-:, ", r) {
+						return fmt.Errorf("invalid selector [%s]", selector)
+					}
+				}
+
+				if reference && strings.ContainsAny(selector, ":,") {
+					return fmt.Errorf("slices and unions can't be used in a reference path")
+				}
+			}
+
+			s = s[n:]
+		default:
+			return fmt.Errorf("unexpected %q", s[0])
+		}
+	}
+
+	return nil
+}
+
+// jsonPathVariableLen returns the length of the variable name at the start of s, or 0.
+// Variable names start with a letter or underscore, followed by letters, digits or underscores.
+func jsonPathVariableLen(s string) int {
+	for i, r := range s {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+
+		return i
+	}
+
+	return len(s)
+}
+
+// jsonPathBracketLen returns the length of the bracketed selector at the start of s, including the brackets.
+func jsonPathBracketLen(s string) (int, error) {
+	depth := 0
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+
+			if depth == 0 {
+				return i + 1, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("unterminated \"[\"")
+}
+
+// validIntrinsicFunction checks the syntax of an intrinsic function call, such as
+// States.Format('Hello, {}', $.name).
+func validIntrinsicFunction(s string) error {
+	p := &intrinsicFunctionParser{s: s}
+
+	if err := p.call(); err != nil {
+		return err
+	}
+
+	if p.skipSpace(); p.i < len(p.s) {
+		return fmt.Errorf("unexpected %q after the call", p.s[p.i:])
+	}
+
+	return nil
+}
+
+type intrinsicFunctionParser struct {
+	i int
+	s string
+}
+
+func (p *intrinsicFunctionParser) skipSpace() {
+	for p.i < len(p.s) && p.s[p.i] == ' ' {
+		p.i++
+	}
+}
+
+func (p *intrinsicFunctionParser) call() error {
+	start := p.i
+
+	for p.i < len(p.s) && (p.s[p.i] == '.' || unicode.IsLetter(rune(p.s[p.i])) || unicode.IsDigit(rune(p.s[p.i]))) {
+		p.i++
+	}
+
+	name := p.s[start:p.i]
+
+	if !definitionIntrinsicFunctions[name] {
+		return fmt.Errorf("unknown function %q", name)
+	}
+
+	if p.i >= len(p.s) || p.s[p.i] != '(' {
+		return fmt.Errorf("expected \"(\" after %s", name)
+	}
+
+	p.i++
+
+	if p.skipSpace(); p.i < len(p.s) && p.s[p.i] == ')' {
+		p.i++
+		return nil
+	}
+
+	for {
+		if err := p.argument(); err != nil {
+			return err
+		}
+
+		if p.skipSpace(); p.i >= len(p.s) {
+			return fmt.Errorf("missing \")\" in call to %s", name)
+		}
+
+		switch p.s[p.i] {
+		case ',':
+			p.i++
+		case ')':
+			p.i++
+			return nil
+		default:
+			return fmt.Errorf("unexpected %q in call to %s", p.s[p.i], name)
+		}
+	}
+}
+
+func (p *intrinsicFunctionParser) argument() error {
+	if p.skipSpace(); p.i >= len(p.s) {
+		return fmt.Errorf("missing argument")
+	}
+
+	switch c := p.s[p.i]; {
+	case c == '\'':
+		for p.i++; p.i < len(p.s); p.i++ {
+			switch p.s[p.i] {
+			case '\\':
+				p.i++
+			case '\'':
+				p.i++
+				return nil
+			}
+		}
+
+		return fmt.Errorf("unterminated string")
+	case c == '$':
+		start := p.i
+		depth := 0
+
+		for ; p.i < len(p.s); p.i++ {
+			if c := p.s[p.i]; c == '[' {
+				depth++
+			} else if c == ']' {
+				depth--
+			} else if depth == 0 && (c == ',' || c == ')' || c == ' ') {
+				break
+			}
+		}
+
+		if err := validJSONPath(p.s[start:p.i], false); err != nil {
+			return fmt.Errorf("invalid path %q: %s", p.s[start:p.i], err)
+		}
+
+		return nil
+	case strings.HasPrefix(p.s[p.i:], "States."):
+		return p.call()
+	default:
+		start := p.i
+
+		for p.i < len(p.s) && p.s[p.i] != ',' && p.s[p.i] != ')' && p.s[p.i] != ' ' {
+			p.i++
+		}
+
+		var literal interface{}
+
+		if err := json.Unmarshal([]byte(p.s[start:p.i]), &literal); err != nil {
+			return fmt.Errorf("invalid argument %q", p.s[start:p.i])
+		}
+
+		return nil
+	}
+}
+
+// definitionFromYAML converts the maps that YAML decodes to maps with string keys.
+func definitionFromYAML(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+
+		for k, e := range v {
+			s, ok := k.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("state machine definition key %v is not a string", k)
+			}
+
+			e, err := definitionFromYAML(e)
+
+			if err != nil {
+				return nil, err
+			}
+
+			m[s] = e
+		}
+
+		return m, nil
+	case []interface{}:
+		l := make([]interface{}, len(v))
+
+		for i, e := range v {
+			e, err := definitionFromYAML(e)
+
+			if err != nil {
+				return nil, err
+			}
+
+			l[i] = e
+		}
+
+		return l, nil
+	default:
+		return v, nil
+	}
+}
+
+// definitionPointerEscape escapes a JSON Pointer reference token.
+func definitionPointerEscape(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+func definitionSortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,97 @@
+package sfn
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourceDefinitionValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDefinitionValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"fail_on_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDefinitionValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	path := cty.GetAttrPath("definition")
+
+	definition, err := DefinitionJSON(d.Get("definition").(string))
+
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid state machine definition",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	definition, err = structure.NormalizeJsonString(definition)
+
+	if err != nil {
+		return diag.Errorf("error normalizing state machine definition: %s", err)
+	}
+
+	problems, err := ValidateDefinition(definition)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	severity := diag.Warning
+
+	if d.Get("fail_on_error").(bool) {
+		severity = diag.Error
+	}
+
+	diags := definitionProblemDiagnostics(problems, severity, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	errors := make([]string, 0, len(problems))
+
+	for _, problem := range problems {
+		if !problem.Warning {
+			errors = append(errors, problem.String())
+		}
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(definition)))
+	d.Set("errors", errors)
+	d.Set("json", definition)
+	d.Set("valid", len(errors) == 0)
+
+	return diags
+}
@@ -0,0 +1,87 @@
+package sfn_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSFNDefinitionValidationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_sfn_definition_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefinitionValidationDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"StartAt":"Wait","States":{"Wait":{"End":true,"Seconds":5,"Type":"Wait"}}}`),
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNDefinitionValidationDataSource_invalid(t *testing.T) {
+	dataSourceName := "data.aws_sfn_definition_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDefinitionValidationDataSourceConfig_invalid(true),
+				ExpectError: regexp.MustCompile(`/States/Wait/Next: state "Done" does not exist`),
+			},
+			{
+				Config: testAccDefinitionValidationDataSourceConfig_invalid(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0", `/States/Wait/Next: state "Done" does not exist`),
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDefinitionValidationDataSourceConfig_basic = `
+data "aws_sfn_definition_validation" "test" {
+  definition = <<EOF
+StartAt: Wait
+States:
+  Wait:
+    Type: Wait
+    Seconds: 5
+    End: true
+EOF
+}
+`
+
+func testAccDefinitionValidationDataSourceConfig_invalid(failOnError bool) string {
+	return fmt.Sprintf(`
+data "aws_sfn_definition_validation" "test" {
+  fail_on_error = %[1]t
+
+  definition = jsonencode({
+    StartAt = "Wait"
+    States = {
+      Wait = {
+        Type    = "Wait"
+        Seconds = 5
+        Next    = "Done"
+      }
+    }
+  })
+}
+`, failOnError)
+}
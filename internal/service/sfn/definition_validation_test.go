@@ -0,0 +1,431 @@
+package sfn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Definition string
+		Problems   []string
+	}{
+		{
+			Name: "valid",
+			Definition: `{
+  "Comment": "Checks an order",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {
+        "FunctionName": "check",
+        "Payload.$": "$",
+        "Message.$": "States.Format('Order {} for {}', $.id, States.ArrayGetItem($.names, 0))"
+      },
+      "ResultSelector": {
+        "Status.$": "$.Payload.status"
+      },
+      "ResultPath": "$.check",
+      "Retry": [
+        {"ErrorEquals": ["Lambda.TooManyRequestsException"], "MaxAttempts": 3},
+        {"ErrorEquals": ["States.ALL"], "IntervalSeconds": 5, "BackoffRate": 2.0}
+      ],
+      "Catch": [
+        {"ErrorEquals": ["States.TaskFailed"], "ResultPath": "$.error", "Next": "Failed"}
+      ],
+      "Next": "Approved?"
+    },
+    "Approved?": {
+      "Type": "Choice",
+      "Choices": [
+        {
+          "And": [
+            {"Variable": "$.check.Status", "StringEquals": "APPROVED"},
+            {"Not": {"Variable": "$.total", "NumericGreaterThanPath": "$.limit"}}
+          ],
+          "Next": "Process"
+        },
+        {"Variable": "$.retry", "IsPresent": true, "Next": "Wait"}
+      ],
+      "Default": "Failed"
+    },
+    "Wait": {"Type": "Wait", "SecondsPath": "$.delay", "Next": "Check"},
+    "Process": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Ship",
+          "States": {"Ship": {"Type": "Pass", "End": true}}
+        },
+        {
+          "StartAt": "Items",
+          "States": {
+            "Items": {
+              "Type": "Map",
+              "ItemsPath": "$.items[*]",
+              "ItemProcessor": {
+                "StartAt": "Item",
+                "States": {"Item": {"Type": "Succeed"}}
+              },
+              "End": true
+            }
+          }
+        }
+      ],
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "OrderFailed"}
+  }
+}`,
+		},
+		{
+			Name: "valid JSONata",
+			Definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Arguments": {"FunctionName": "check", "Payload": "{% $states.input %}"},
+      "Assign": {"status": "{% $states.result.Payload.status %}"},
+      "Output": "{% $states.result.Payload %}",
+      "TimeoutSeconds": "{% $states.input.timeout %}",
+      "Catch": [
+        {"ErrorEquals": ["States.ALL"], "Assign": {"error": "{% $states.errorOutput %}"}, "Output": {}, "Next": "Failed"}
+      ],
+      "Next": "Approved?"
+    },
+    "Approved?": {
+      "Type": "Choice",
+      "Choices": [
+        {"Condition": "{% $status = 'APPROVED' %}", "Assign": {"approved": true}, "Next": "Items"}
+      ],
+      "Default": "Wait"
+    },
+    "Wait": {"Type": "Wait", "Seconds": "{% $states.input.delay %}", "Next": "Check"},
+    "Items": {
+      "Type": "Map",
+      "Items": "{% $states.input.items %}",
+      "MaxConcurrency": "{% $states.input.concurrency %}",
+      "ItemProcessor": {
+        "StartAt": "Item",
+        "States": {"Item": {"Type": "Pass", "QueryLanguage": "JSONPath", "End": true}}
+      },
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed", "Output": {"status": "{% $status %}"}},
+    "Failed": {"Type": "Fail", "Error": "OrderFailed", "Cause": "{% $error.Cause %}"}
+  }
+}`,
+		},
+		{
+			Name:       "invalid QueryLanguage",
+			Definition: `{"QueryLanguage": "JMESPath", "StartAt": "A", "States": {"A": {"Type": "Succeed"}}}`,
+			Problems:   []string{`/QueryLanguage: must be one of the following: "JSONata", "JSONPath"`},
+		},
+		{
+			Name:       "missing StartAt",
+			Definition: `{"States": {"A": {"Type": "Succeed"}}}`,
+			Problems:   []string{": StartAt is required"},
+		},
+		{
+			Name:       "unknown state type",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Sleep"}}}`,
+			Problems:   []string{`/States/A/Type: must be one of the following: "Choice", "Fail", "Map", "Parallel", "Pass", "Succeed", "Task", "Wait"`},
+		},
+		{
+			Name:       "unknown field",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "Next": "B"}}}`,
+			Problems:   []string{"warning: /States/A/Next: Additional property Next is not allowed"},
+		},
+		{
+			Name:       "unknown field with invalid transition",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Nxt": "B"}}}`,
+			Problems: []string{
+				"/States/A: Pass state must have Next or End",
+				"warning: /States/A/Nxt: Additional property Nxt is not allowed",
+			},
+		},
+		{
+			Name:       "missing StartAt state",
+			Definition: `{"StartAt": "B", "States": {"A": {"Type": "Succeed"}}}`,
+			Problems: []string{
+				`/StartAt: state "B" does not exist`,
+				"/States/A: state is not reachable from StartAt",
+			},
+		},
+		{
+			Name:       "missing Next state",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}}}`,
+			Problems:   []string{`/States/A/Next: state "B" does not exist`},
+		},
+		{
+			Name:       "no Next or End",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			Problems:   []string{"/States/A: Pass state must have Next or End"},
+		},
+		{
+			Name:       "unreachable state",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Succeed"}, "B": {"Type": "Succeed"}}}`,
+			Problems:   []string{"/States/B: state is not reachable from StartAt"},
+		},
+		{
+			Name: "transition out of branch",
+			Definition: `{"StartAt": "P", "States": {
+  "P": {"Type": "Parallel", "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "Done"}}}], "Next": "Done"},
+  "Done": {"Type": "Succeed"}
+}}`,
+			Problems: []string{`/States/P/Branches/0/States/A/Next: state "Done" does not exist`},
+		},
+		{
+			Name: "duplicate state name",
+			Definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Map", "Iterator": {"StartAt": "A", "States": {"A": {"Type": "Succeed"}}}, "End": true}
+}}`,
+			Problems: []string{`/States/A/Iterator/States/A: state name "A" is already used by /States/A`},
+		},
+		{
+			Name:       "Wait without duration",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Wait", "Seconds": 5, "TimestampPath": "$.t", "End": true}}}`,
+			Problems:   []string{"/States/A: Wait state must have exactly one of Seconds, SecondsPath, Timestamp or TimestampPath"},
+		},
+		{
+			Name: "invalid error names",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::sqs:sendMessage", "End": true,
+  "Retry": [{"ErrorEquals": ["States.ALL", "States.Timeout"]}, {"ErrorEquals": ["States.Unknown"]}]
+}}}`,
+			Problems: []string{
+				"/States/A/Retry/0/ErrorEquals/0: States.ALL must be the only error name",
+				"/States/A/Retry/0/ErrorEquals/0: States.ALL must be in the last retrier or catcher",
+				`/States/A/Retry/1/ErrorEquals/0: "States.Unknown" is not a predefined error name`,
+			},
+		},
+		{
+			Name: "invalid Choice rules",
+			Definition: `{"StartAt": "C", "States": {
+  "C": {"Type": "Choice", "Choices": [
+    {"Variable": "$.a", "Next": "Done"},
+    {"Variable": "$.a", "NumericEquals": "1", "Next": "Done"},
+    {"And": [{"Variable": "$.a", "IsNull": true, "Next": "Done"}], "Next": "Done"},
+    {"Or": [], "Next": "Done"},
+    {"Variable": "a", "StringEquals": "x", "Next": "Done"},
+    {"Variable": "$.a", "StringEqual": "x", "Next": "Done"},
+    {"Variable": "$.a", "IsString": true}
+  ]},
+  "Done": {"Type": "Succeed"}
+}}`,
+			Problems: []string{
+				"/States/C/Choices/0: Choice rule with Variable must have exactly one comparison operator, found 0",
+				"/States/C/Choices/1/NumericEquals: NumericEquals must be a number",
+				"/States/C/Choices/2/And/0/Next: only top-level Choice rules can have Next",
+				"/States/C/Choices/3/Or: Or must be a non-empty array of Choice rules",
+				`warning: /States/C/Choices/4/Variable: invalid path "a": must start with "$"`,
+				"/States/C/Choices/5: Choice rule with Variable must have exactly one comparison operator, found 0",
+				`warning: /States/C/Choices/5/StringEqual: unknown Choice rule field "StringEqual"`,
+				"/States/C/Choices/6: Choice rule must have Next",
+			},
+		},
+		{
+			Name:       "invalid paths",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "$.a[", "OutputPath": "$$.Execution.Id", "ResultPath": "$.items[*]", "End": true}}}`,
+			Problems: []string{
+				`warning: /States/A/InputPath: invalid path "$.a[": unterminated "["`,
+				`warning: /States/A/ResultPath: invalid path "$.items[*]": wildcards can't be used in a reference path`,
+			},
+		},
+		{
+			Name: "invalid intrinsic functions",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true, "Parameters": {
+  "a.$": "States.Formatt('{}', $.a)",
+  "b.$": "States.Format('{}', $.a",
+  "c.$": "States.Format('{}, $.a)",
+  "d.$": "States.MathAdd($.a, 1)",
+  "nested": {"e.$": "a"}
+}}}}`,
+			Problems: []string{
+				`warning: /States/A/Parameters/a.$: invalid intrinsic function: unknown function "States.Formatt"`,
+				"warning: /States/A/Parameters/b.$: invalid intrinsic function: missing \")\" in call to States.Format",
+				"warning: /States/A/Parameters/c.$: invalid intrinsic function: unterminated string",
+				`warning: /States/A/Parameters/nested/e.$: invalid path "a": must start with "$"`,
+			},
+		},
+		{
+			Name:       "variable InputPath and OutputPath",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Assign": {"x": {"items": [1]}}, "InputPath": "$x", "OutputPath": "$x.items[0]", "End": true}}}`,
+		},
+		{
+			Name:       "variable payload template",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"v.$": "$x", "w.$": "$order.id", "nested": {"i.$": "$_i2"}}, "End": true}}}`,
+		},
+		{
+			Name:       "variable intrinsic function arguments",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"f.$": "States.Format('a {} b', $x)", "g.$": "States.ArrayGetItem($order.items, $i)"}, "End": true}}}`,
+		},
+		{
+			Name: "variable Choice rules",
+			Definition: `{"StartAt": "C", "States": {
+  "C": {"Type": "Choice", "Choices": [
+    {"Variable": "$x", "StringEquals": "a", "Next": "Done"},
+    {"Not": {"Variable": "$order.total", "NumericGreaterThanPath": "$order.limit"}, "Next": "Done"}
+  ], "Default": "Done"},
+  "Done": {"Type": "Succeed"}
+}}`,
+		},
+		{
+			Name:       "variable ItemsPath",
+			Definition: `{"StartAt": "M", "States": {"M": {"Type": "Map", "ItemsPath": "$order.items", "ItemProcessor": {"StartAt": "I", "States": {"I": {"Type": "Succeed"}}}, "End": true}}}`,
+		},
+		{
+			Name:       "variable Wait path",
+			Definition: `{"StartAt": "W", "States": {"W": {"Type": "Wait", "SecondsPath": "$delay", "End": true}}}`,
+		},
+		{
+			Name:       "invalid variables",
+			Definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "$1x", "ResultPath": "$x", "Parameters": {"v.$": "$x-y"}, "End": true}}}`,
+			Problems: []string{
+				`warning: /States/A/InputPath: invalid path "$1x": unexpected '1'`,
+				`warning: /States/A/Parameters/v.$: invalid path "$x-y": unexpected '-'`,
+				`warning: /States/A/ResultPath: invalid path "$x": variables can't be used in a reference path`,
+			},
+		},
+		{
+			Name:       "escaped state name",
+			Definition: `{"StartAt": "a/b", "States": {"a/b": {"Type": "Pass", "Next": "c~d"}}}`,
+			Problems:   []string{`/States/a~1b/Next: state "c~d" does not exist`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			problems, err := ValidateDefinition(testCase.Definition)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+
+			for _, problem := range problems {
+				if problem.Warning {
+					got = append(got, "warning: "+problem.Path+": "+problem.Message)
+				} else {
+					got = append(got, problem.Path+": "+problem.Message)
+				}
+			}
+
+			if strings.Join(got, "\n") != strings.Join(testCase.Problems, "\n") {
+				t.Errorf("got problems:\n%s\n\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(testCase.Problems, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidateDefinition_notJSON(t *testing.T) {
+	if _, err := ValidateDefinition(`StartAt: A`); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestDefinitionJSON(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Definition string
+		Expected   string
+		Error      bool
+	}{
+		{
+			Name:       "JSON",
+			Definition: `{"StartAt": "A"}`,
+			Expected:   `{"StartAt": "A"}`,
+		},
+		{
+			Name: "YAML",
+			Definition: `
+StartAt: A
+States:
+  A:
+    Type: Wait
+    Seconds: 5
+    End: true
+`,
+			Expected: `{"StartAt":"A","States":{"A":{"End":true,"Seconds":5,"Type":"Wait"}}}`,
+		},
+		{
+			Name:       "invalid",
+			Definition: "StartAt: [A",
+			Error:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := DefinitionJSON(testCase.Definition)
+
+			if testCase.Error {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestValidDefinition(t *testing.T) {
+	path := cty.GetAttrPath("definition")
+
+	diags := validDefinition(`{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}}}`, path)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+	}
+
+	if !diags[0].AttributePath.Equals(path) {
+		t.Errorf("got attribute path %#v", diags[0].AttributePath)
+	}
+
+	if expected := `/States/A/Next: state "B" does not exist`; diags[0].Detail != expected {
+		t.Errorf("got detail %q, expected %q", diags[0].Detail, expected)
+	}
+
+	diags = validDefinition(`{"StartAt": "A", "States": {"A": {"Type": "Succeed", "Future": true}}}`, path)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic for an unknown field, got %d", len(diags))
+	}
+
+	if diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning for an unknown field, got severity %v", diags[0].Severity)
+	}
+
+	diags = validDefinition(`{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "$.a[", "End": true}}}`, path)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic for an invalid path, got %d", len(diags))
+	}
+
+	if diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning for an invalid path, got severity %v", diags[0].Severity)
+	}
+
+	if diags := validDefinition(strings.Repeat(" ", definitionMaxLen+1), path); len(diags) != 1 {
+		t.Errorf("expected 1 diagnostic for a definition that is too long, got %d", len(diags))
+	}
+}
@@ -75,6 +75,7 @@ func ResourceSigningProfilePermission() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSigningProfilePermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SignerConn
 
@@ -30,6 +30,7 @@ func ResourceDomain() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceDomainCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SimpleDBConn
 
@@ -135,6 +135,7 @@ func ResourceSMSPreferences() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSMSPreferencesSet(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SNSConn
 
@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -57,10 +56,6 @@ var (
 			Optional:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
 		},
 		"owner_id": {
 			Type:     schema.TypeString,
@@ -113,7 +108,10 @@ var (
 		"redrive_policy":                 SubscriptionAttributeNameRedrivePolicy,
 		"subscription_role_arn":          SubscriptionAttributeNameSubscriptionRoleARN,
 		"topic_arn":                      SubscriptionAttributeNameTopicARN,
-	}, subscriptionSchema)
+	}, subscriptionSchema).
+		WithJSONAttribute("delivery_policy").
+		WithJSONAttribute("filter_policy").
+		WithJSONAttribute("redrive_policy")
 )
 
 func ResourceTopicSubscription() *schema.Resource {
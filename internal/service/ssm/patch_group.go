@@ -71,6 +71,7 @@ func resourcePatchGroupRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error parsing SSM Patch Group ID (%s): %w", d.Id(), err)
 	}
 
+	//lintignore:AWSR003
 	group, err := FindPatchGroup(conn, patchGroup, baselineId)
 
 	if err != nil {
@@ -141,6 +141,7 @@ func resourceAccountAssignmentRead(d *schema.ResourceData, meta interface{}) err
 	permissionSetArn := idParts[4]
 	instanceArn := idParts[5]
 
+	//lintignore:AWSR003
 	accountAssignment, err := FindAccountAssignment(conn, principalID, principalType, targetID, permissionSetArn, instanceArn)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
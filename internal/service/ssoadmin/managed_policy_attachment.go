@@ -88,6 +88,7 @@ func resourceManagedPolicyAttachmentRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("error parsing SSO Managed Policy Attachment ID: %w", err)
 	}
 
+	//lintignore:AWSR003
 	policy, err := FindManagedPolicy(conn, managedPolicyArn, permissionSetArn, instanceArn)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
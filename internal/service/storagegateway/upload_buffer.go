@@ -104,6 +104,7 @@ func resourceUploadBufferRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	//lintignore:AWSR003
 	foundDiskID, err := FindUploadBufferDisk(conn, gatewayARN, diskID)
 
 	if !d.IsNewResource() && IsErrGatewayNotFound(err) {
@@ -50,6 +50,7 @@ func ResourceSSHKey() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).TransferConn
 	userName := d.Get("user_name").(string)
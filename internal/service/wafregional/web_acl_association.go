@@ -39,6 +39,7 @@ func ResourceWebACLAssociation() *schema.Resource {
 	}
 }
 
+// lintignore:AWSR004
 func resourceWebACLAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WAFRegionalConn
 
@@ -0,0 +1,363 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// creationTimeAttributes are the attribute names, in order of preference, read to
+// determine when a resource was created.
+var creationTimeAttributes = []string{
+	"creation_date",
+	"create_date",
+	"created_date",
+	"creation_time",
+	"create_time",
+	"created_time",
+	"created_at",
+	"creation_timestamp",
+	"created_timestamp",
+	"launch_time",
+}
+
+var creationTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05.000Z0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// TagFilter matches a resource tag by key and, optionally, value.
+// Values may contain "*" wildcards.
+type TagFilter struct {
+	Key   string
+	Value *regexp.Regexp
+}
+
+func (tf TagFilter) match(tags map[string]string) bool {
+	v, ok := tags[tf.Key]
+
+	if !ok {
+		return false
+	}
+
+	return tf.Value == nil || tf.Value.MatchString(v)
+}
+
+func (tf TagFilter) String() string {
+	if tf.Value == nil {
+		return tf.Key
+	}
+
+	return fmt.Sprintf("%s=%s", tf.Key, tf.Value)
+}
+
+// ParseTagFilters parses a comma-separated list of "key" or "key=value" tag filters.
+func ParseTagFilters(s string) ([]TagFilter, error) {
+	var filters []TagFilter
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+
+		if v == "" {
+			continue
+		}
+
+		parts := strings.SplitN(v, "=", 2)
+
+		if parts[0] == "" {
+			return nil, fmt.Errorf("invalid tag filter (%s): empty key", v)
+		}
+
+		tf := TagFilter{Key: parts[0]}
+
+		if len(parts) == 2 {
+			tf.Value = regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(parts[1]), `\*`, ".*") + "$")
+		}
+
+		filters = append(filters, tf)
+	}
+
+	return filters, nil
+}
+
+// Filter decides whether a resource may be swept. A resource is swept only if it
+// matches every configured criterion.
+type Filter struct {
+	// IncludeTags, if set, requires the resource to have at least one matching tag.
+	IncludeTags []TagFilter
+
+	// ExcludeTags prevents sweeping resources with any matching tag.
+	ExcludeTags []TagFilter
+
+	// IncludeName, if set, requires the resource's name or ID to match.
+	IncludeName *regexp.Regexp
+
+	// ExcludeName prevents sweeping resources whose name or ID matches.
+	ExcludeName *regexp.Regexp
+
+	// MinAge, if set, prevents sweeping resources created more recently than this
+	// or whose creation time is unknown.
+	MinAge time.Duration
+
+	now func() time.Time
+}
+
+// FilterFromEnv returns the Filter configured by the TF_AWS_SWEEP_* environment
+// variables, or nil if no filtering is configured.
+func FilterFromEnv() (*Filter, error) {
+	f := &Filter{}
+	configured := false
+
+	if v := os.Getenv(conns.EnvVarSweepIncludeTags); v != "" {
+		tfs, err := ParseTagFilters(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepIncludeTags, err)
+		}
+		f.IncludeTags = tfs
+		configured = true
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepExcludeTags); v != "" {
+		tfs, err := ParseTagFilters(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepExcludeTags, err)
+		}
+		f.ExcludeTags = tfs
+		configured = true
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepIncludeNameRegex); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepIncludeNameRegex, err)
+		}
+		f.IncludeName = re
+		configured = true
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepExcludeNameRegex); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepExcludeNameRegex, err)
+		}
+		f.ExcludeName = re
+		configured = true
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepMinAge, err)
+		}
+		f.MinAge = d
+		configured = true
+	}
+
+	if !configured {
+		return nil, nil
+	}
+
+	return f, nil
+}
+
+// sweepAttributes are the properties of a resource that filters are evaluated against.
+type sweepAttributes struct {
+	ID      string
+	Name    string
+	Tags    map[string]string
+	Created *time.Time
+}
+
+// Match returns whether a resource may be swept and, if not, the reason why.
+func (f *Filter) Match(attrs sweepAttributes) (bool, string) {
+	if f.IncludeName != nil && !f.IncludeName.MatchString(attrs.Name) && !f.IncludeName.MatchString(attrs.ID) {
+		return false, fmt.Sprintf("name does not match %q", f.IncludeName)
+	}
+
+	if f.ExcludeName != nil && (f.ExcludeName.MatchString(attrs.Name) || f.ExcludeName.MatchString(attrs.ID)) {
+		return false, fmt.Sprintf("name matches excluded %q", f.ExcludeName)
+	}
+
+	for _, tf := range f.ExcludeTags {
+		if tf.match(attrs.Tags) {
+			return false, fmt.Sprintf("tag matches excluded %q", tf)
+		}
+	}
+
+	if len(f.IncludeTags) > 0 {
+		matched := false
+
+		for _, tf := range f.IncludeTags {
+			if tf.match(attrs.Tags) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false, "no tag matches included tags"
+		}
+	}
+
+	if f.MinAge > 0 {
+		if attrs.Created == nil {
+			return false, "creation time unknown"
+		}
+
+		now := time.Now
+		if f.now != nil {
+			now = f.now
+		}
+
+		if age := now().Sub(*attrs.Created); age < f.MinAge {
+			return false, fmt.Sprintf("age %s is less than %s", age.Round(time.Second), f.MinAge)
+		}
+	}
+
+	return true, ""
+}
+
+// needsRead returns whether the filter inspects attributes that sweepers typically
+// do not set, meaning the resource must be read before the filter is evaluated.
+func (f *Filter) needsRead() bool {
+	return len(f.IncludeTags) > 0 || len(f.ExcludeTags) > 0 || f.MinAge > 0
+}
+
+// filterSweepResources returns the resources that pass the environment-configured Filter.
+// Resources whose attributes cannot be read are not swept.
+func filterSweepResources(ctx context.Context, sweepResources []*SweepResource) ([]*SweepResource, error) {
+	f, err := FilterFromEnv()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if f == nil {
+		return sweepResources, nil
+	}
+
+	var result []*SweepResource
+
+	for _, sweepResource := range sweepResources {
+		id := sweepResource.d.Id()
+
+		if f.needsRead() {
+			if err := readResource(ctx, sweepResource); err != nil {
+				log.Printf("[WARN] Skipping sweep of resource (%s): reading resource: %s", id, err)
+				skipSweepResource(sweepResource, id, fmt.Sprintf("error reading resource: %s", err))
+				continue
+			}
+
+			if sweepResource.d.Id() == "" {
+				log.Printf("[DEBUG] Skipping sweep of resource (%s): not found", id)
+				continue
+			}
+		}
+
+		if ok, reason := f.Match(sweepResourceAttributes(sweepResource)); !ok {
+			log.Printf("[INFO] Skipping sweep of resource (%s): %s", id, reason)
+			skipSweepResource(sweepResource, id, reason)
+			continue
+		}
+
+		result = append(result, sweepResource)
+	}
+
+	return result, nil
+}
+
+func skipSweepResource(sweepResource *SweepResource, id, reason string) {
+	if dryRunReport != nil {
+		dryRunReport.addSkippedResource(sweepResource, id, reason)
+	}
+}
+
+func readResource(ctx context.Context, sweepResource *SweepResource) error {
+	r, d, meta := sweepResource.resource, sweepResource.d, sweepResource.meta
+
+	var diags diag.Diagnostics
+
+	switch {
+	case r.ReadContext != nil:
+		diags = r.ReadContext(ctx, d, meta)
+	case r.ReadWithoutTimeout != nil:
+		diags = r.ReadWithoutTimeout(ctx, d, meta)
+	case r.Read != nil:
+		return r.Read(d, meta)
+	}
+
+	for i := range diags {
+		if diags[i].Severity == diag.Error {
+			return fmt.Errorf("%s", diags[i].Summary)
+		}
+	}
+
+	return nil
+}
+
+func sweepResourceAttributes(sweepResource *SweepResource) sweepAttributes {
+	attrs := sweepAttributes{
+		ID: sweepResource.d.Id(),
+	}
+
+	schema := sweepResource.resource.Schema
+
+	if _, ok := schema["name"]; ok {
+		attrs.Name, _ = sweepResource.d.Get("name").(string)
+	}
+
+	attrs.Tags = sweepResourceTags(sweepResource)
+
+	for _, k := range creationTimeAttributes {
+		if _, ok := schema[k]; !ok {
+			continue
+		}
+
+		if t, ok := parseCreationTime(sweepResource.d.Get(k)); ok {
+			attrs.Created = &t
+			break
+		}
+	}
+
+	return attrs
+}
+
+func parseCreationTime(v interface{}) (time.Time, bool) {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return time.Time{}, false
+		}
+
+		for _, layout := range creationTimeLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, true
+			}
+		}
+
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.Unix(n, 0), true
+		}
+	case int:
+		if v > 0 {
+			return time.Unix(int64(v), 0), true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,177 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestParseTagFilters(t *testing.T) {
+	filters, err := ParseTagFilters("Persistent, Owner=platform-*,,Env=prod")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(filters) != 3 {
+		t.Fatalf("expected 3 filters, got %d", len(filters))
+	}
+
+	if filters[0].Key != "Persistent" || filters[0].Value != nil {
+		t.Errorf("unexpected filter: %s", filters[0])
+	}
+
+	if !filters[1].match(map[string]string{"Owner": "platform-team"}) {
+		t.Errorf("expected wildcard tag value to match")
+	}
+
+	if filters[2].match(map[string]string{"Env": "production"}) {
+		t.Errorf("expected exact tag value not to match")
+	}
+
+	if _, err := ParseTagFilters("=value"); err == nil {
+		t.Errorf("expected error for empty key")
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	excludeTags, _ := ParseTagFilters("Persistent")
+	includeTags, _ := ParseTagFilters("Purpose=acceptance-*")
+
+	testCases := []struct {
+		Name     string
+		Filter   *Filter
+		Attrs    sweepAttributes
+		Expected bool
+	}{
+		{
+			Name:     "include name by ID",
+			Filter:   &Filter{IncludeName: regexp.MustCompile(`^tf-acc-test-`)},
+			Attrs:    sweepAttributes{ID: "tf-acc-test-123"},
+			Expected: true,
+		},
+		{
+			Name:     "include name no match",
+			Filter:   &Filter{IncludeName: regexp.MustCompile(`^tf-acc-test-`)},
+			Attrs:    sweepAttributes{ID: "vpc-12345678", Name: "shared"},
+			Expected: false,
+		},
+		{
+			Name:     "exclude name",
+			Filter:   &Filter{ExcludeName: regexp.MustCompile(`fixture`)},
+			Attrs:    sweepAttributes{ID: "i-12345678", Name: "long-lived-fixture"},
+			Expected: false,
+		},
+		{
+			Name:     "exclude tag",
+			Filter:   &Filter{ExcludeTags: excludeTags},
+			Attrs:    sweepAttributes{ID: "tf-acc-test-123", Tags: map[string]string{"Persistent": "true"}},
+			Expected: false,
+		},
+		{
+			Name:     "include tag",
+			Filter:   &Filter{IncludeTags: includeTags},
+			Attrs:    sweepAttributes{ID: "tf-acc-test-123", Tags: map[string]string{"Purpose": "acceptance-test"}},
+			Expected: true,
+		},
+		{
+			Name:     "include tag missing",
+			Filter:   &Filter{IncludeTags: includeTags},
+			Attrs:    sweepAttributes{ID: "tf-acc-test-123"},
+			Expected: false,
+		},
+		{
+			Name:     "old enough",
+			Filter:   &Filter{MinAge: 24 * time.Hour, now: func() time.Time { return now }},
+			Attrs:    sweepAttributes{ID: "tf-acc-test-123", Created: &old},
+			Expected: true,
+		},
+		{
+			Name:     "too recent",
+			Filter:   &Filter{MinAge: 24 * time.Hour, now: func() time.Time { return now }},
+			Attrs:    sweepAttributes{ID: "tf-acc-test-123", Created: &recent},
+			Expected: false,
+		},
+		{
+			Name:     "age unknown",
+			Filter:   &Filter{MinAge: 24 * time.Hour, now: func() time.Time { return now }},
+			Attrs:    sweepAttributes{ID: "tf-acc-test-123"},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, reason := testCase.Filter.Match(testCase.Attrs)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t (%s), expected %t", got, reason, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestParseCreationTime(t *testing.T) {
+	for _, v := range []interface{}{
+		"2022-05-01T12:00:00Z",
+		"2022-05-01T12:00:00.000+0000",
+		"2022-05-01 12:00:00",
+		"1651406400",
+		1651406400,
+	} {
+		if _, ok := parseCreationTime(v); !ok {
+			t.Errorf("expected %v to parse", v)
+		}
+	}
+
+	for _, v := range []interface{}{"", "yesterday", nil, 0} {
+		if _, ok := parseCreationTime(v); ok {
+			t.Errorf("expected %v not to parse", v)
+		}
+	}
+}
+
+func TestDeleteResource(t *testing.T) {
+	t.Setenv(conns.EnvVarSweepExcludeNameRegex, "^keep-")
+
+	var deleted []string
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted = append(deleted, d.Id())
+
+			return nil
+		},
+	}
+
+	for _, id := range []string{"keep-123", "tf-acc-test-123"} {
+		d := r.Data(nil)
+		d.SetId(id)
+		d.Set("name", id)
+
+		if err := DeleteResource(r, d, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, expected := strings.Join(deleted, ","), "tf-acc-test-123"; got != expected {
+		t.Errorf("got deleted %q, expected %q", got, expected)
+	}
+}
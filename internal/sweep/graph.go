@@ -0,0 +1,141 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var (
+	sweepers   = make(map[string]*resource.Sweeper)
+	sweepersMu sync.Mutex
+)
+
+// AddTestSweepers registers a sweeper with the Terraform Plugin SDK and records it,
+// along with its Dependencies, so that the ordered and dry-run sweeper modes can
+// build a dependency graph.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepersMu.Lock()
+	sweepers[name] = s
+	sweepersMu.Unlock()
+
+	resource.AddTestSweepers(name, s)
+}
+
+// filterSweepers returns the sweepers whose names contain any of the
+// comma-separated filter values, plus all of their transitive dependencies.
+// An empty filter returns all sweepers.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		s, ok := source[name]
+		if !ok {
+			log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+			return
+		}
+
+		result[name] = s
+
+		for _, dep := range s.Dependencies {
+			add(dep)
+		}
+	}
+
+	for name := range source {
+		for _, v := range strings.Split(strings.ToLower(filter), ",") {
+			if v != "" && strings.Contains(strings.ToLower(name), v) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// sweeperLevels orders sweepers topologically so that every sweeper appears in a
+// later level than all of its dependencies. Sweepers within a level do not
+// depend on each other and may be run concurrently. Names within each level are
+// sorted for deterministic output.
+func sweeperLevels(sweepers map[string]*resource.Sweeper) ([][]string, error) {
+	inDegree := make(map[string]int, len(sweepers))
+	dependents := make(map[string][]string, len(sweepers))
+
+	for name, s := range sweepers {
+		if _, ok := inDegree[name]; !ok {
+			inDegree[name] = 0
+		}
+
+		for _, dep := range s.Dependencies {
+			if _, ok := sweepers[dep]; !ok {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dep)
+			}
+
+			inDegree[name]++
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+
+	var levels [][]string
+	var current []string
+
+	for name, n := range inDegree {
+		if n == 0 {
+			current = append(current, name)
+		}
+	}
+
+	visited := 0
+
+	for len(current) > 0 {
+		sort.Strings(current)
+		levels = append(levels, current)
+		visited += len(current)
+
+		var next []string
+
+		for _, name := range current {
+			for _, dependent := range dependents[name] {
+				inDegree[dependent]--
+
+				if inDegree[dependent] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+
+		current = next
+	}
+
+	if visited != len(inDegree) {
+		var cycle []string
+
+		for name, n := range inDegree {
+			if n > 0 {
+				cycle = append(cycle, name)
+			}
+		}
+
+		sort.Strings(cycle)
+
+		return nil, fmt.Errorf("sweeper dependency cycle detected between: %s", strings.Join(cycle, ", "))
+	}
+
+	return levels, nil
+}
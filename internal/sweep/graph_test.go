@@ -0,0 +1,112 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSweeperLevels(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Sweepers      map[string]*resource.Sweeper
+		Expected      [][]string
+		ExpectedError bool
+	}{
+		{
+			Name: "no dependencies",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_b": {Name: "aws_b"},
+				"aws_a": {Name: "aws_a"},
+			},
+			Expected: [][]string{{"aws_a", "aws_b"}},
+		},
+		{
+			Name: "chain",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_vpc":               {Name: "aws_vpc", Dependencies: []string{"aws_subnet", "aws_internet_gateway"}},
+				"aws_subnet":            {Name: "aws_subnet", Dependencies: []string{"aws_network_interface"}},
+				"aws_network_interface": {Name: "aws_network_interface"},
+				"aws_internet_gateway":  {Name: "aws_internet_gateway"},
+			},
+			Expected: [][]string{
+				{"aws_internet_gateway", "aws_network_interface"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			Name: "missing dependency",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_missing"}},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "cycle",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+				"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+				"aws_c": {Name: "aws_c"},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := sweeperLevels(testCase.Sweepers)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	source := map[string]*resource.Sweeper{
+		"aws_vpc":              {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+		"aws_subnet":           {Name: "aws_subnet"},
+		"aws_s3_bucket":        {Name: "aws_s3_bucket", Dependencies: []string{"aws_s3_access_point"}},
+		"aws_s3_access_point":  {Name: "aws_s3_access_point"},
+		"aws_internet_gateway": {Name: "aws_internet_gateway"},
+	}
+
+	got := filterSweepers("aws_vpc", source)
+
+	if len(got) != 2 || got["aws_vpc"] == nil || got["aws_subnet"] == nil {
+		t.Errorf("unexpected filtered sweepers: %v", got)
+	}
+
+	if got := filterSweepers("", source); len(got) != len(source) {
+		t.Errorf("expected all sweepers, got %d", len(got))
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"DescribeVpcs":       true,
+		"ListBuckets":        true,
+		"GetBucketTagging":   true,
+		"DeleteVpc":          false,
+		"TerminateInstances": false,
+		"PutBucketPolicy":    false,
+	} {
+		if got := isReadOnlyOperation(name); got != expected {
+			t.Errorf("isReadOnlyOperation(%q) = %t, expected %t", name, got, expected)
+		}
+	}
+}
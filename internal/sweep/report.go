@@ -0,0 +1,324 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	ReportFormatJSON     = "json"
+	ReportFormatMarkdown = "markdown"
+)
+
+// Report is the result of a dry-run sweep.
+type Report struct {
+	// Order lists, per region, the levels sweepers were run in.
+	Order map[string][][]string `json:"order"`
+
+	Resources         []ReportResource         `json:"resources"`
+	SkippedResources  []ReportSkippedResource  `json:"skipped_resources"`
+	BlockedOperations []ReportBlockedOperation `json:"blocked_operations"`
+	Errors            []ReportError            `json:"errors"`
+
+	current map[string]string
+	mu      sync.Mutex
+}
+
+// ReportResource is a resource that would have been deleted by a sweeper.
+type ReportResource struct {
+	Sweeper string            `json:"sweeper"`
+	ID      string            `json:"id"`
+	Region  string            `json:"region"`
+	Tags    map[string]string `json:"tags,omitempty"`
+}
+
+// ReportSkippedResource is a resource that was not swept because of the sweeper filter.
+type ReportSkippedResource struct {
+	Sweeper string `json:"sweeper"`
+	ID      string `json:"id"`
+	Region  string `json:"region"`
+	Reason  string `json:"reason"`
+}
+
+// ReportBlockedOperation is a mutating API call made directly by a sweeper,
+// rather than through SweepOrchestrator, that was prevented from being sent.
+type ReportBlockedOperation struct {
+	Sweeper   string `json:"sweeper"`
+	Region    string `json:"region"`
+	Operation string `json:"operation"`
+}
+
+// ReportError is a sweeper that failed or was skipped.
+type ReportError struct {
+	Sweeper string `json:"sweeper"`
+	Region  string `json:"region"`
+	Error   string `json:"error"`
+}
+
+func newReport() *Report {
+	return &Report{
+		Order:   make(map[string][][]string),
+		current: make(map[string]string),
+	}
+}
+
+// setCurrent records the sweeper running in a region.
+// Sweepers run one at a time per region in dry-run mode.
+func (r *Report) setCurrent(region, sweeper string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.current[region] = sweeper
+}
+
+func (r *Report) setOrder(region string, levels [][]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Order[region] = levels
+}
+
+func (r *Report) addSweepResource(sweepResource *SweepResource) {
+	region := sweepResource.region()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Resources = append(r.Resources, ReportResource{
+		Sweeper: r.current[region],
+		ID:      sweepResource.d.Id(),
+		Region:  region,
+		Tags:    sweepResourceTags(sweepResource),
+	})
+}
+
+func (r *Report) addSkippedResource(sweepResource *SweepResource, id, reason string) {
+	region := sweepResource.region()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.SkippedResources = append(r.SkippedResources, ReportSkippedResource{
+		Sweeper: r.current[region],
+		ID:      id,
+		Region:  region,
+		Reason:  reason,
+	})
+}
+
+func (r *Report) addBlockedOperation(region, operation string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.BlockedOperations = append(r.BlockedOperations, ReportBlockedOperation{
+		Sweeper:   r.current[region],
+		Region:    region,
+		Operation: operation,
+	})
+}
+
+func (r *Report) addError(region, sweeper string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Errors = append(r.Errors, ReportError{
+		Sweeper: sweeper,
+		Region:  region,
+		Error:   err.Error(),
+	})
+}
+
+func (r *Report) sort() {
+	sort.SliceStable(r.Resources, func(i, j int) bool {
+		a, b := r.Resources[i], r.Resources[j]
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		if a.Sweeper != b.Sweeper {
+			return a.Sweeper < b.Sweeper
+		}
+		return a.ID < b.ID
+	})
+	sort.SliceStable(r.SkippedResources, func(i, j int) bool {
+		a, b := r.SkippedResources[i], r.SkippedResources[j]
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		if a.Sweeper != b.Sweeper {
+			return a.Sweeper < b.Sweeper
+		}
+		return a.ID < b.ID
+	})
+	sort.SliceStable(r.BlockedOperations, func(i, j int) bool {
+		a, b := r.BlockedOperations[i], r.BlockedOperations[j]
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		if a.Sweeper != b.Sweeper {
+			return a.Sweeper < b.Sweeper
+		}
+		return a.Operation < b.Operation
+	})
+	sort.SliceStable(r.Errors, func(i, j int) bool {
+		a, b := r.Errors[i], r.Errors[j]
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		return a.Sweeper < b.Sweeper
+	})
+}
+
+// Write writes the report in the specified format.
+func (r *Report) Write(w io.Writer, format string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sort()
+
+	switch format {
+	case "", ReportFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(r)
+	case ReportFormatMarkdown:
+		return r.writeMarkdown(w)
+	default:
+		return fmt.Errorf("unsupported sweeper report format: %s", format)
+	}
+}
+
+func (r *Report) writeMarkdown(w io.Writer) error {
+	var sb strings.Builder
+
+	sb.WriteString("# Sweeper Dry Run\n")
+
+	regions := make([]string, 0, len(r.Order))
+	for region := range r.Order {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		fmt.Fprintf(&sb, "\n## %s\n\n", region)
+
+		sb.WriteString("### Order\n\n")
+		for i, level := range r.Order[region] {
+			fmt.Fprintf(&sb, "%d. %s\n", i+1, "`"+strings.Join(level, "`, `")+"`")
+		}
+
+		sb.WriteString("\n### Resources\n\n")
+		sb.WriteString("| Sweeper | ID | Tags |\n")
+		sb.WriteString("|---------|----|------|\n")
+		for _, v := range r.Resources {
+			if v.Region != region {
+				continue
+			}
+			fmt.Fprintf(&sb, "| `%s` | `%s` | %s |\n", v.Sweeper, v.ID, markdownTags(v.Tags))
+		}
+
+		var skipped []string
+		for _, v := range r.SkippedResources {
+			if v.Region == region {
+				skipped = append(skipped, fmt.Sprintf("| `%s` | `%s` | %s |\n", v.Sweeper, v.ID, v.Reason))
+			}
+		}
+		if len(skipped) > 0 {
+			sb.WriteString("\n### Skipped Resources\n\n")
+			sb.WriteString("| Sweeper | ID | Reason |\n")
+			sb.WriteString("|---------|----|--------|\n")
+			sb.WriteString(strings.Join(skipped, ""))
+		}
+
+		var blocked []string
+		for _, v := range r.BlockedOperations {
+			if v.Region == region {
+				blocked = append(blocked, fmt.Sprintf("- `%s`: `%s`\n", v.Sweeper, v.Operation))
+			}
+		}
+		if len(blocked) > 0 {
+			sb.WriteString("\n### Blocked Operations\n\n")
+			sb.WriteString(strings.Join(blocked, ""))
+		}
+
+		var errs []string
+		for _, v := range r.Errors {
+			if v.Region == region {
+				errs = append(errs, fmt.Sprintf("- `%s`: %s\n", v.Sweeper, v.Error))
+			}
+		}
+		if len(errs) > 0 {
+			sb.WriteString("\n### Errors\n\n")
+			sb.WriteString(strings.Join(errs, ""))
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+func markdownTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("`%s=%s`", k, tags[k]))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// sweepResourceTags returns the tags known for a resource about to be swept.
+// Most sweepers only set the resource ID, in which case no tags are returned.
+func sweepResourceTags(sweepResource *SweepResource) map[string]string {
+	if sweepResource.resource == nil || sweepResource.d == nil {
+		return nil
+	}
+
+	if _, ok := sweepResource.resource.Schema["tags"]; !ok {
+		return nil
+	}
+
+	v, ok := sweepResource.d.Get("tags").(map[string]interface{})
+	if !ok || len(v) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string, len(v))
+	for k, v := range v {
+		tags[k], _ = v.(string)
+	}
+
+	return tags
+}
+
+func writeReport(r *Report) error {
+	w := io.Writer(os.Stdout)
+
+	if filename := os.Getenv(conns.EnvVarSweepReportFile); filename != "" {
+		f, err := os.Create(filename)
+		if err != nil {
+			return fmt.Errorf("error creating sweeper report file (%s): %w", filename, err)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	return r.Write(w, strings.ToLower(os.Getenv(conns.EnvVarSweepReportFormat)))
+}
@@ -0,0 +1,308 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const defaultSweepConcurrency = 10
+
+var (
+	// dryRunReport collects what would be swept. It is nil unless running in dry-run mode.
+	dryRunReport *Report
+
+	// deleteSemaphores bounds concurrent resource deletions per region in ordered mode.
+	deleteSemaphores   map[string]chan struct{}
+	deleteSemaphoresMu sync.Mutex
+)
+
+// TestMain adds ordered and dry-run sweeper modes to the "go test" command.
+//
+// When the TF_AWS_SWEEP_ORDERED or TF_AWS_SWEEP_DRY_RUN environment variable is set,
+// sweepers registered with AddTestSweepers are run in topological order of their
+// Dependencies, level by level, with at most TF_AWS_SWEEP_CONCURRENCY sweepers and
+// resource deletions in flight per region. Regions are swept in parallel.
+//
+// In dry-run mode no resources are deleted. Resources passed to SweepOrchestrator
+// or DeleteResource are recorded, mutating API calls made directly by sweepers are blocked, and a
+// report is written in TF_AWS_SWEEP_REPORT_FORMAT to TF_AWS_SWEEP_REPORT_FILE.
+//
+// Otherwise the Terraform Plugin SDK's TestMain is used.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" || !(orderedMode() || dryRunMode()) {
+		resource.TestMain(m)
+		return
+	}
+
+	if dryRunMode() {
+		dryRunReport = newReport()
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+	err := runSweepers(strings.Split(regions, ","), filterSweepers(flagValue("sweep-run"), sweepers), allowFailures)
+
+	if dryRunReport != nil {
+		if err := writeReport(dryRunReport); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, allowFailures bool) error {
+	levels, err := sweeperLevels(sweepers)
+
+	if err != nil {
+		return err
+	}
+
+	var g multierror.Group
+
+	for _, region := range regions {
+		region := strings.TrimSpace(region)
+
+		g.Go(func() error {
+			return runRegionSweepers(region, levels, sweepers, allowFailures)
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// runRegionSweepers runs sweepers in a single region one dependency level at a time.
+// Sweepers whose dependencies failed are skipped.
+func runRegionSweepers(region string, levels [][]string, sweepers map[string]*resource.Sweeper, allowFailures bool) error {
+	concurrency := sweepConcurrency()
+
+	if dryRunReport != nil {
+		// Resources are attributed to the running sweeper, so run one at a time.
+		concurrency = 1
+		dryRunReport.setOrder(region, levels)
+	}
+
+	failed := make(map[string]bool)
+	var errs *multierror.Error
+	var mu sync.Mutex
+
+	start := time.Now()
+	log.Printf("[DEBUG] Running %d Sweepers in %d levels for region (%s)", len(sweepers), len(levels), region)
+
+	for i, level := range levels {
+		sem := make(chan struct{}, concurrency)
+		var wg sync.WaitGroup
+
+		for _, name := range level {
+			s := sweepers[name]
+
+			mu.Lock()
+			dep := failedDependency(s, failed)
+			if dep != "" {
+				failed[name] = true
+			}
+			mu.Unlock()
+
+			if dep != "" {
+				log.Printf("[WARN] Sweeper (%s) for region (%s) skipped: dependency (%s) failed", name, region, dep)
+				continue
+			}
+
+			wg.Add(1)
+
+			go func(name string, s *resource.Sweeper) {
+				defer wg.Done()
+
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				if dryRunReport != nil {
+					dryRunReport.setCurrent(region, name)
+				}
+
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s), level %d", name, region, i+1)
+				start := time.Now()
+				err := s.F(region)
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+
+				if err == nil {
+					return
+				}
+
+				log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+
+				if dryRunReport != nil {
+					// Errors are expected from sweepers whose deletions were blocked,
+					// so report them without failing the run or skipping dependents.
+					dryRunReport.addError(region, name, err)
+					return
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				failed[name] = true
+				errs = multierror.Append(errs, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err))
+			}(name, s)
+		}
+
+		wg.Wait()
+
+		if errs != nil && !allowFailures {
+			return errs
+		}
+	}
+
+	log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+	return errs.ErrorOrNil()
+}
+
+func failedDependency(s *resource.Sweeper, failed map[string]bool) string {
+	for _, dep := range s.Dependencies {
+		if failed[dep] {
+			return dep
+		}
+	}
+
+	return ""
+}
+
+// acquireDeleteSlot blocks until a resource deletion may start in the region.
+// The returned function releases the slot. Deletions are unbounded unless
+// running in ordered mode.
+func acquireDeleteSlot(region string) func() {
+	if !orderedMode() && !dryRunMode() {
+		return func() {}
+	}
+
+	deleteSemaphoresMu.Lock()
+	if deleteSemaphores == nil {
+		deleteSemaphores = make(map[string]chan struct{})
+	}
+	sem, ok := deleteSemaphores[region]
+	if !ok {
+		sem = make(chan struct{}, sweepConcurrency())
+		deleteSemaphores[region] = sem
+	}
+	deleteSemaphoresMu.Unlock()
+
+	sem <- struct{}{}
+
+	return func() { <-sem }
+}
+
+// readOnlyOperationPrefixes are the API operation name prefixes that are allowed in dry-run mode
+// and, when a sweeper filter is configured, outside of DeleteResource and SweepOrchestrator.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Check",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// blockMutatingOperations adds a validation handler to every AWS SDK for Go v1
+// service client that refuses to send mutating API calls, failing them with the
+// error returned by block instead. This protects against sweepers that delete
+// resources directly rather than via DeleteResource or SweepOrchestrator.
+func blockMutatingOperations(client *conns.AWSClient, block func(operation string) error) {
+	handlersType := reflect.TypeOf(request.Handlers{})
+	v := reflect.ValueOf(client).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+
+		if f.Kind() != reflect.Ptr || f.IsNil() || f.Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		h := f.Elem().FieldByName("Handlers")
+
+		if !h.IsValid() || h.Type() != handlersType || !h.CanAddr() {
+			continue
+		}
+
+		handlers := h.Addr().Interface().(*request.Handlers)
+		handlers.Validate.PushFrontNamed(request.NamedHandler{
+			Name: "sweep.BlockMutatingOperations",
+			Fn: func(r *request.Request) {
+				if r.Operation == nil || isReadOnlyOperation(r.Operation.Name) {
+					return
+				}
+
+				r.Error = block(fmt.Sprintf("%s:%s", r.ClientInfo.ServiceName, r.Operation.Name))
+			},
+		})
+	}
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+func orderedMode() bool {
+	v, _ := strconv.ParseBool(os.Getenv(conns.EnvVarSweepOrdered))
+
+	return v
+}
+
+func dryRunMode() bool {
+	v, _ := strconv.ParseBool(os.Getenv(conns.EnvVarSweepDryRun))
+
+	return v
+}
+
+func sweepConcurrency() int {
+	if v, err := strconv.Atoi(os.Getenv(conns.EnvVarSweepConcurrency)); err == nil && v > 0 {
+		return v
+	}
+
+	return defaultSweepConcurrency
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -34,6 +35,13 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
 
+// sweeperDeleteClients are the regional conns.AWSClient used to delete resources
+// that have passed the sweeper filter. They are only set when a filter is configured.
+var sweeperDeleteClients = make(map[string]interface{})
+
+// sweeperClientsMu serializes access to SweeperClients and sweeperDeleteClients, as sweepers may run concurrently.
+var sweeperClientsMu sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
@@ -41,6 +49,9 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 }
 
 func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (interface{}, error) {
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
 	if client, ok := SweeperClients[region]; ok {
 		return client, nil
 	}
@@ -90,6 +101,30 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		return nil, fmt.Errorf("error getting AWS client: %#v", diags)
 	}
 
+	if dryRunReport != nil {
+		blockMutatingOperations(client.(*conns.AWSClient), func(operation string) error {
+			dryRunReport.addBlockedOperation(region, operation)
+
+			return fmt.Errorf("sweeper dry run: blocked mutating operation (%s)", operation)
+		})
+	} else if f, err := FilterFromEnv(); err != nil {
+		return nil, err
+	} else if f != nil {
+		// Resources deleted via DeleteResource or SweepOrchestrator are filtered and then
+		// deleted with a separate client. Mutating API calls made directly by sweepers
+		// can't be filtered, so they are blocked.
+		deleteClient, diags := conf.Client(ctx)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error getting AWS client: %#v", diags)
+		}
+
+		blockMutatingOperations(client.(*conns.AWSClient), func(operation string) error {
+			return fmt.Errorf("sweeper filter: blocked mutating operation (%s) made outside of sweep.DeleteResource or sweep.SweepOrchestrator", operation)
+		})
+
+		sweeperDeleteClients[region] = deleteClient
+	}
+
 	SweeperClients[region] = client
 
 	return client, nil
@@ -109,6 +144,26 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 	}
 }
 
+// deleteMeta returns the provider meta used to delete the resource.
+func (sr *SweepResource) deleteMeta() interface{} {
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
+	if client, ok := sweeperDeleteClients[sr.region()]; ok {
+		return client
+	}
+
+	return sr.meta
+}
+
+func (sr *SweepResource) region() string {
+	if client, ok := sr.meta.(*conns.AWSClient); ok {
+		return client.Region
+	}
+
+	return ""
+}
+
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorWithContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
@@ -116,12 +171,28 @@ func SweepOrchestrator(sweepResources []*SweepResource) error {
 func SweepOrchestratorWithContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
 	var g multierror.Group
 
+	sweepResources, err := filterSweepResources(ctx, sweepResources)
+
+	if err != nil {
+		return err
+	}
+
+	if dryRunReport != nil {
+		for _, sweepResource := range sweepResources {
+			dryRunReport.addSweepResource(sweepResource)
+		}
+
+		return nil
+	}
+
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
 		g.Go(func() error {
+			defer acquireDeleteSlot(sweepResource.region())()
+
 			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+				err := deleteResource(sweepResource.resource, sweepResource.d, sweepResource.deleteMeta())
 
 				if err != nil {
 					if strings.Contains(err.Error(), "Throttling") {
@@ -136,7 +207,7 @@ func SweepOrchestratorWithContext(ctx context.Context, sweepResources []*SweepRe
 			})
 
 			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+				err = deleteResource(sweepResource.resource, sweepResource.d, sweepResource.deleteMeta())
 			}
 
 			return err
@@ -212,7 +283,31 @@ func SkipSweepError(err error) bool {
 	return false
 }
 
+// DeleteResource deletes a single resource, for sweepers that do not use SweepOrchestrator.
+// The resource is only deleted if it passes the sweeper filter. In dry-run mode it is
+// recorded instead.
 func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	sweepResources, err := filterSweepResources(context.Background(), []*SweepResource{NewSweepResource(resource, d, meta)})
+
+	if err != nil {
+		return err
+	}
+
+	for _, sweepResource := range sweepResources {
+		if dryRunReport != nil {
+			dryRunReport.addSweepResource(sweepResource)
+			continue
+		}
+
+		if err := deleteResource(sweepResource.resource, sweepResource.d, sweepResource.deleteMeta()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
@@ -22,12 +22,49 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// IgnoreConfig, if set, removes ignored tags from Tags when merging with
+	// or removing from resource tags.
+	IgnoreConfig *IgnoreConfig
 }
 
 // IgnoreConfig contains various options for removing resource tags.
+// When CaseInsensitive is set, Keys and KeyPrefixes are matched ignoring case;
+// KeyRegexes must be compiled with the (?i) flag to do the same.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys            KeyValueTags
+	KeyPrefixes     KeyValueTags
+	KeyRegexes      []*regexp.Regexp
+	CaseInsensitive bool
+}
+
+// DefaultTagsIgnoreConfig returns the IgnoreConfig applied to default tags, or nil.
+// Only KeyRegexes and CaseInsensitive apply to default tags, so default tags
+// matching Keys or KeyPrefixes are still merged into resource tags. Keys and
+// KeyPrefixes never applied to default tags, and applying them now would
+// silently remove tags from existing resources.
+func (config *IgnoreConfig) DefaultTagsIgnoreConfig() *IgnoreConfig {
+	if config == nil || (len(config.KeyRegexes) == 0 && !config.CaseInsensitive) {
+		return nil
+	}
+
+	return &IgnoreConfig{
+		KeyRegexes:      config.KeyRegexes,
+		CaseInsensitive: config.CaseInsensitive,
+	}
+}
+
+// RequiredConfig contains tags that must be present on all resources.
+type RequiredConfig struct {
+	Tags []*RequiredTag
+}
+
+// RequiredTag is a tag key that must be present, optionally restricted
+// to a set of allowed values and/or values matching a regular expression.
+type RequiredTag struct {
+	Key           string
+	AllowedValues []string
+	ValueRegex    *regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -61,12 +98,26 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+// Default tags removed by the DefaultConfig's IgnoreConfig are not merged.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
 	}
 
-	return dc.Tags.Merge(tags)
+	defaultTags := dc.Tags.IgnoreConfig(dc.IgnoreConfig)
+
+	if dc.IgnoreConfig != nil && dc.IgnoreConfig.CaseInsensitive {
+		// Resource tags override default tags whose keys differ only in case.
+		for k := range tags {
+			for dk := range defaultTags {
+				if dk != k && strings.EqualFold(dk, k) {
+					delete(defaultTags, dk)
+				}
+			}
+		}
+	}
+
+	return defaultTags.Merge(tags)
 }
 
 // TagsEqual returns true if the given configuration's Tags
@@ -88,18 +139,130 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// Validate returns an error listing any required tag keys that are missing
+// from the given tags or whose values are not allowed.
+func (rc *RequiredConfig) Validate(tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var missing, invalid []string
+
+	for _, rt := range rc.Tags {
+		if rt == nil {
+			continue
+		}
+
+		if !tags.KeyExists(rt.Key) {
+			missing = append(missing, rt.Key)
+			continue
+		}
+
+		if err := rt.validateValue(tags.KeyValue(rt.Key)); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s (%s)", rt.Key, err))
+		}
+	}
+
+	var errs []string
+
+	if len(missing) > 0 {
+		errs = append(errs, fmt.Sprintf("missing required tags: %s", strings.Join(missing, ", ")))
+	}
+
+	if len(invalid) > 0 {
+		errs = append(errs, fmt.Sprintf("invalid values for required tags: %s", strings.Join(invalid, ", ")))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf(`"tags" do not satisfy the "required_tags" configuration block of the provider: %s`, strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func (rt *RequiredTag) validateValue(v *string) error {
+	var value string
+
+	if v != nil {
+		value = *v
+	}
+
+	if len(rt.AllowedValues) > 0 {
+		allowed := false
+
+		for _, av := range rt.AllowedValues {
+			if value == av {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("value %q is not one of %q", value, rt.AllowedValues)
+		}
+	}
+
+	if rt.ValueRegex != nil && !rt.ValueRegex.MatchString(value) {
+		return fmt.Errorf("value %q does not match %q", value, rt.ValueRegex)
+	}
+
+	return nil
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
 		return tags
 	}
 
-	result := tags.IgnorePrefixes(config.KeyPrefixes)
-	result = result.Ignore(config.Keys)
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if config.ignoreKey(k) {
+			continue
+		}
+
+		result[k] = v
+	}
 
 	return result
 }
 
+// ignoreKey returns whether the tag key is removed by the configuration.
+func (config *IgnoreConfig) ignoreKey(k string) bool {
+	if config.CaseInsensitive {
+		for ignoreKey := range config.Keys {
+			if strings.EqualFold(k, ignoreKey) {
+				return true
+			}
+		}
+
+		for ignoreKeyPrefix := range config.KeyPrefixes {
+			if len(k) >= len(ignoreKeyPrefix) && strings.EqualFold(k[:len(ignoreKeyPrefix)], ignoreKeyPrefix) {
+				return true
+			}
+		}
+	} else {
+		if _, ok := config.Keys[k]; ok {
+			return true
+		}
+
+		for ignoreKeyPrefix := range config.KeyPrefixes {
+			if strings.HasPrefix(k, ignoreKeyPrefix) {
+				return true
+			}
+		}
+	}
+
+	for _, re := range config.KeyRegexes {
+		if re.MatchString(k) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
@@ -477,10 +640,23 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 		return tags
 	}
 
+	defaultTags := dc.Tags.IgnoreConfig(dc.IgnoreConfig)
+	caseInsensitive := dc.IgnoreConfig != nil && dc.IgnoreConfig.CaseInsensitive
 	result := make(KeyValueTags)
 
 	for k, v := range tags {
-		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+		defaultVal, ok := defaultTags[k]
+
+		if !ok && caseInsensitive {
+			for dk, dv := range defaultTags {
+				if strings.EqualFold(dk, k) {
+					defaultVal, ok = dv, true
+					break
+				}
+			}
+		}
+
+		if !ok || !v.Equal(defaultVal) {
 			result[k] = v
 		}
 	}
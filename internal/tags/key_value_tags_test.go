@@ -1,6 +1,8 @@
 package tags
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -173,6 +175,85 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "ignored default tags",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key2": "value2",
+					"key3": "value3",
+				}),
+				IgnoreConfig: &IgnoreConfig{
+					KeyRegexes: []*regexp.Regexp{regexp.MustCompile(`^key3$`)},
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "ignore tags keys and key prefixes",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key2":  "value2",
+					"key3a": "value3",
+				}),
+				IgnoreConfig: (&IgnoreConfig{
+					Keys:        New([]string{"key2"}),
+					KeyPrefixes: New([]string{"key3"}),
+				}).DefaultTagsIgnoreConfig(),
+			},
+			want: map[string]string{
+				"key1":  "value1",
+				"key2":  "value2",
+				"key3a": "value3",
+			},
+		},
+		{
+			name: "ignore tags keys and key regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key2": "value2",
+					"key3": "value3",
+				}),
+				IgnoreConfig: (&IgnoreConfig{
+					Keys:       New([]string{"key2"}),
+					KeyRegexes: []*regexp.Regexp{regexp.MustCompile(`^key3$`)},
+				}).DefaultTagsIgnoreConfig(),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "case insensitive override",
+			tags: New(map[string]string{
+				"patch group": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"Patch Group": "value2",
+					"key2":        "value2",
+				}),
+				IgnoreConfig: &IgnoreConfig{
+					CaseInsensitive: true,
+				},
+			},
+			want: map[string]string{
+				"patch group": "value1",
+				"key2":        "value2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -291,6 +372,149 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRequiredConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		requiredConfig *RequiredConfig
+		wantErr        *regexp.Regexp
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: nil,
+		},
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: &RequiredConfig{},
+		},
+		{
+			name: "keys present",
+			tags: New(map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "team-a",
+			}),
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "CostCenter"},
+					{Key: "Owner"},
+				},
+			},
+		},
+		{
+			name: "keys missing",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "CostCenter"},
+					{Key: "Owner"},
+				},
+			},
+			wantErr: regexp.MustCompile(`missing required tags: CostCenter, Owner$`),
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "CostCenter"},
+				},
+			},
+			wantErr: regexp.MustCompile(`missing required tags: CostCenter$`),
+		},
+		{
+			name: "allowed value",
+			tags: New(map[string]string{
+				"Environment": "production",
+			}),
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "Environment", AllowedValues: []string{"production", "staging"}},
+				},
+			},
+		},
+		{
+			name: "value not allowed",
+			tags: New(map[string]string{
+				"Environment": "test",
+			}),
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "Environment", AllowedValues: []string{"production", "staging"}},
+				},
+			},
+			wantErr: regexp.MustCompile(`invalid values for required tags: Environment \(value "test" is not one of`),
+		},
+		{
+			name: "value matching regex",
+			tags: New(map[string]string{
+				"CostCenter": "1234",
+			}),
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "CostCenter", ValueRegex: regexp.MustCompile(`^[0-9]{4}$`)},
+				},
+			},
+		},
+		{
+			name: "value not matching regex",
+			tags: New(map[string]string{
+				"CostCenter": "abc",
+			}),
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "CostCenter", ValueRegex: regexp.MustCompile(`^[0-9]{4}$`)},
+				},
+			},
+			wantErr: regexp.MustCompile(`invalid values for required tags: CostCenter \(value "abc" does not match`),
+		},
+		{
+			name: "missing and invalid",
+			tags: New(map[string]string{
+				"CostCenter": "abc",
+			}),
+			requiredConfig: &RequiredConfig{
+				Tags: []*RequiredTag{
+					{Key: "CostCenter", ValueRegex: regexp.MustCompile(`^[0-9]{4}$`)},
+					{Key: "Owner"},
+				},
+			},
+			wantErr: regexp.MustCompile(`missing required tags: Owner; invalid values for required tags: CostCenter`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.Validate(testCase.tags)
+
+			if testCase.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %q, got none", testCase.wantErr)
+			}
+
+			if !testCase.wantErr.MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %q", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:aws-in-func-name
 	testCases := []struct {
 		name string
@@ -533,6 +757,59 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(map[string]string{
+				"aws:cloudformation:stack-name": "value1",
+				"kubernetes.io/cluster/example": "owned",
+				"key3":                          "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^aws:cloudformation:`),
+					regexp.MustCompile(`^kubernetes\.io/cluster/.+$`),
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "case sensitive keys not matching",
+			tags: New(map[string]string{
+				"Patch Group": "value1",
+				"key2":        "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"patch group",
+				}),
+			},
+			want: map[string]string{
+				"Patch Group": "value1",
+				"key2":        "value2",
+			},
+		},
+		{
+			name: "case insensitive keys and prefixes matching",
+			tags: New(map[string]string{
+				"Patch Group": "value1",
+				"KEY2":        "value2",
+				"key3":        "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"patch group",
+				}),
+				KeyPrefixes: New([]string{
+					"key2",
+				}),
+				CaseInsensitive: true,
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -544,6 +821,57 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestIgnoreConfigDefaultTagsIgnoreConfig(t *testing.T) {
+	keyRegexes := []*regexp.Regexp{regexp.MustCompile(`^key3$`)}
+
+	testCases := []struct {
+		name         string
+		ignoreConfig *IgnoreConfig
+		want         *IgnoreConfig
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "keys and key prefixes",
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key1"}),
+				KeyPrefixes: New([]string{"key2"}),
+			},
+		},
+		{
+			name: "key regexes",
+			ignoreConfig: &IgnoreConfig{
+				Keys:       New([]string{"key1"}),
+				KeyRegexes: keyRegexes,
+			},
+			want: &IgnoreConfig{
+				KeyRegexes: keyRegexes,
+			},
+		},
+		{
+			name: "case insensitive",
+			ignoreConfig: &IgnoreConfig{
+				KeyPrefixes:     New([]string{"key2"}),
+				CaseInsensitive: true,
+			},
+			want: &IgnoreConfig{
+				CaseInsensitive: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.ignoreConfig.DefaultTagsIgnoreConfig()
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %#v, expected %#v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -2109,6 +2437,62 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "ignored default tags",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+				IgnoreConfig: &IgnoreConfig{
+					Keys: New([]string{
+						"key2",
+					}),
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "ignore tags keys",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+				IgnoreConfig: (&IgnoreConfig{
+					Keys: New([]string{"key2"}),
+				}).DefaultTagsIgnoreConfig(),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "case insensitive keys",
+			tags: New(map[string]string{
+				"patch group": "value1",
+				"key2":        "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"Patch Group": "value1",
+				}),
+				IgnoreConfig: &IgnoreConfig{
+					CaseInsensitive: true,
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
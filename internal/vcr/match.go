@@ -0,0 +1,170 @@
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// matchHeaders are the request headers, other than method, URL and body, that
+// identify an API operation. JSON protocol services put the operation name in X-Amz-Target.
+var matchHeaders = []string{
+	"X-Amz-Target",
+}
+
+// volatileQueryParameters are removed from recorded URLs. They carry request signatures.
+var volatileQueryParameters = []string{
+	"X-Amz-Algorithm",
+	"X-Amz-Credential",
+	"X-Amz-Date",
+	"X-Amz-Expires",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+	"X-Amz-SignedHeaders",
+}
+
+// substitutedValues match values that differ between recording and replay and that
+// are echoed back in responses: random test resource names, and UUIDs such as
+// SDK-generated idempotency tokens.
+var substitutedValues = regexp.MustCompile(`tf[-_]acc[-_]test[-_][0-9A-Za-z]+|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+
+// ignoredValues match values that differ between recording and replay but are
+// not substituted in responses: timestamps and resource.UniqueId() style tokens.
+var ignoredValues = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}(:|%3A)\d{2}(:|%3A)\d{2}(\.\d+)?(Z|[+-]\d{2}(:|%3A)?\d{2})?|\d{20,}`)
+
+// matchKey returns a normalized representation of the request used to match
+// live requests to recorded ones.
+func (r Request) matchKey() string {
+	var sb strings.Builder
+
+	sb.WriteString(r.Method)
+	sb.WriteString(" ")
+	sb.WriteString(normalizeURL(r.URL))
+
+	for _, k := range matchHeaders {
+		sb.WriteString("\n")
+		sb.WriteString(k)
+		sb.WriteString(": ")
+		sb.WriteString(r.Headers[k])
+	}
+
+	sb.WriteString("\n\n")
+	sb.WriteString(normalizeBody(r.Body))
+
+	return normalizeValues(sb.String())
+}
+
+func normalizeValues(s string) string {
+	s = substitutedValues.ReplaceAllString(s, "{substituted}")
+	s = ignoredValues.ReplaceAllString(s, "{ignored}")
+
+	return s
+}
+
+func normalizeURL(s string) string {
+	u, err := url.Parse(s)
+
+	if err != nil {
+		return s
+	}
+
+	u.RawQuery = u.Query().Encode()
+
+	return u.String()
+}
+
+// normalizeBody sorts form-encoded parameters and JSON object keys so that
+// serialization order does not affect matching.
+func normalizeBody(body string) string {
+	trimmed := strings.TrimSpace(body)
+
+	if trimmed == "" {
+		return ""
+	}
+
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var v interface{}
+
+		if err := json.Unmarshal([]byte(trimmed), &v); err == nil {
+			b, err := json.Marshal(v)
+
+			if err == nil {
+				return string(b)
+			}
+		}
+
+		return trimmed
+	}
+
+	if !strings.HasPrefix(trimmed, "<") {
+		if values, err := url.ParseQuery(trimmed); err == nil {
+			return values.Encode()
+		}
+	}
+
+	return trimmed
+}
+
+func scrubURL(u *url.URL) string {
+	v := *u
+	query := v.Query()
+
+	for _, k := range volatileQueryParameters {
+		query.Del(k)
+	}
+
+	v.RawQuery = query.Encode()
+
+	return v.String()
+}
+
+// learnSubstitutions pairs the substituted values in a recorded request with
+// those in the equivalent live request, so that recorded values can be replaced
+// with live ones in responses.
+func learnSubstitutions(substitutions map[string]string, recorded, live Request) {
+	recordedValues := substitutedValues.FindAllString(recorded.URL+"\n"+normalizeBody(recorded.Body), -1)
+	liveValues := substitutedValues.FindAllString(live.URL+"\n"+normalizeBody(live.Body), -1)
+
+	if len(recordedValues) != len(liveValues) {
+		return
+	}
+
+	for i, v := range recordedValues {
+		if v != liveValues[i] {
+			substitutions[v] = liveValues[i]
+		}
+	}
+}
+
+// substitute replaces recorded values with live ones, longest first so that a
+// value that is a prefix of another is not replaced within it.
+func substitute(s string, substitutions map[string]string) string {
+	if len(substitutions) == 0 || s == "" {
+		return s
+	}
+
+	keys := make([]string, 0, len(substitutions))
+
+	for k := range substitutions {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+
+	pairs := make([]string, 0, 2*len(keys))
+
+	for _, k := range keys {
+		pairs = append(pairs, k, substitutions[k])
+	}
+
+	var buf bytes.Buffer
+
+	_, _ = strings.NewReplacer(pairs...).WriteString(&buf, s)
+
+	return buf.String()
+}
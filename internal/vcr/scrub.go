@@ -0,0 +1,47 @@
+package vcr
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// sensitiveFields are API request and response fields whose values are never recorded.
+var sensitiveFields = []string{
+	"AuthToken",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Password",
+	"PrivateKey",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+}
+
+// scrubbedResponseHeaders are response headers that are never recorded.
+var scrubbedResponseHeaders = map[string]struct{}{
+	"Set-Cookie": {},
+}
+
+var (
+	scrubJSON = regexp.MustCompile(fmt.Sprintf(`("(?:%s)"\s*:\s*)"(?:[^"\\]|\\.)*"`, strings.Join(sensitiveFields, "|")))
+	scrubXML  = regexp.MustCompile(fmt.Sprintf(`<(%s)>[^<]*</(?:%s)>`, strings.Join(sensitiveFields, "|"), strings.Join(sensitiveFields, "|")))
+	scrubForm = regexp.MustCompile(fmt.Sprintf(`(^|&)((?:[^&=]*\.)?(?:%s))=[^&]*`, strings.Join(sensitiveFields, "|")))
+)
+
+// scrub replaces the values of sensitive fields in JSON, XML and form-encoded bodies.
+func scrub(body string) string {
+	if body == "" {
+		return body
+	}
+
+	body = scrubJSON.ReplaceAllString(body, `$1"`+redacted+`"`)
+	body = scrubXML.ReplaceAllString(body, `<$1>`+redacted+`</$1>`)
+	body = scrubForm.ReplaceAllString(body, `$1$2=`+redacted)
+
+	return body
+}
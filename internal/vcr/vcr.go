@@ -0,0 +1,339 @@
+// Package vcr records AWS API HTTP exchanges to cassette files and replays them,
+// so that acceptance tests can exercise resource logic without AWS credentials.
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode a Recorder operates in.
+type Mode int
+
+const (
+	// ModeDisabled sends requests unmodified.
+	ModeDisabled Mode = iota
+
+	// ModeRecord sends requests to AWS and records the exchanges.
+	ModeRecord
+
+	// ModeReplay serves responses from a cassette without sending requests.
+	ModeReplay
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeRecord:
+		return "record"
+	case ModeReplay:
+		return "replay"
+	default:
+		return "disabled"
+	}
+}
+
+// ParseMode parses a Mode from its string representation. An empty string is ModeDisabled.
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(s) {
+	case "", "disabled":
+		return ModeDisabled, nil
+	case "record":
+		return ModeRecord, nil
+	case "replay":
+		return ModeReplay, nil
+	default:
+		return ModeDisabled, fmt.Errorf("unsupported VCR mode (%s), expected one of: record, replay", s)
+	}
+}
+
+// Cassette is a sequence of recorded HTTP exchanges.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded HTTP exchange.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	used bool
+}
+
+// Request is a recorded HTTP request. Only headers used for matching are kept.
+type Request struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette from a file.
+func LoadCassette(filename string) (*Cassette, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cassette{}
+
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("error reading VCR cassette (%s): %w", filename, err)
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to a file, creating parent directories as needed.
+func (c *Cassette) Save(filename string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(b, '\n'), 0644) //nolint:gosec
+}
+
+// CassetteFilename returns the cassette file for a test, replacing characters
+// that cannot be used in file names.
+func CassetteFilename(dir, testName string) string {
+	name := strings.NewReplacer("/", "_", " ", "_", ":", "_").Replace(testName)
+
+	return filepath.Join(dir, name+".json")
+}
+
+// Recorder is an http.RoundTripper that records or replays HTTP exchanges
+// to or from the current cassette.
+type Recorder struct {
+	mode      Mode
+	transport http.RoundTripper
+
+	mu            sync.Mutex
+	cassette      *Cassette
+	substitutions map[string]string
+}
+
+// NewRecorder returns a Recorder. In ModeRecord, requests are sent via transport.
+func NewRecorder(mode Mode, transport http.RoundTripper) *Recorder {
+	return &Recorder{
+		mode:      mode,
+		transport: transport,
+	}
+}
+
+// Mode returns the Recorder's mode.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// HTTPClient returns an HTTP client that sends requests via the Recorder.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Start makes c the current cassette. In ModeRecord c is typically empty.
+func (r *Recorder) Start(c *Cassette) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette = c
+	r.substitutions = make(map[string]string)
+}
+
+// Stop returns the current cassette and clears it.
+func (r *Recorder) Stop() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := r.cassette
+	r.cassette = nil
+	r.substitutions = nil
+
+	return c
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch r.mode {
+	case ModeRecord:
+		return r.record(req, body)
+	case ModeReplay:
+		return r.replay(req, body)
+	default:
+		return r.transport.RoundTrip(req)
+	}
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette != nil {
+		r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+			Request:  newRequest(req, body),
+			Response: newResponse(resp, respBody),
+		})
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		return nil, fmt.Errorf("VCR replay: no cassette loaded for %s %s", req.Method, req.URL)
+	}
+
+	live := newRequest(req, body)
+	key := live.matchKey()
+
+	// Requests are matched in recorded order. Polling may send an identical request
+	// more often on replay than when recording, so the last match is reused.
+	var match, last *Interaction
+
+	for _, i := range r.cassette.Interactions {
+		if i.Request.matchKey() != key {
+			continue
+		}
+
+		last = i
+
+		if !i.used {
+			match = i
+			break
+		}
+	}
+
+	if match == nil {
+		match = last
+	}
+
+	if match == nil {
+		return nil, fmt.Errorf("VCR replay: no recorded interaction matches %s %s", req.Method, req.URL)
+	}
+
+	match.used = true
+	learnSubstitutions(r.substitutions, match.Request, live)
+
+	return match.Response.httpResponse(req, r.substitutions), nil
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+func newRequest(req *http.Request, body []byte) Request {
+	r := Request{
+		Method: req.Method,
+		URL:    scrubURL(req.URL),
+		Body:   scrub(string(body)),
+	}
+
+	for _, k := range matchHeaders {
+		if v := req.Header.Get(k); v != "" {
+			if r.Headers == nil {
+				r.Headers = make(map[string]string)
+			}
+			r.Headers[k] = v
+		}
+	}
+
+	return r
+}
+
+func newResponse(resp *http.Response, body []byte) Response {
+	r := Response{
+		StatusCode: resp.StatusCode,
+		Headers:    make(map[string][]string),
+		Body:       scrub(string(body)),
+	}
+
+	for k, v := range resp.Header {
+		if _, ok := scrubbedResponseHeaders[http.CanonicalHeaderKey(k)]; ok {
+			continue
+		}
+		r.Headers[k] = v
+	}
+
+	return r
+}
+
+func (r Response) httpResponse(req *http.Request, substitutions map[string]string) *http.Response {
+	body := substitute(r.Body, substitutions)
+	header := make(http.Header, len(r.Headers))
+
+	for k, v := range r.Headers {
+		for _, v := range v {
+			header.Add(k, substitute(v, substitutions))
+		}
+	}
+
+	header.Del("Content-Length")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,172 @@
+package vcr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseMode(t *testing.T) {
+	for s, expected := range map[string]Mode{
+		"":       ModeDisabled,
+		"record": ModeRecord,
+		"REPLAY": ModeReplay,
+	} {
+		got, err := ParseMode(s)
+
+		if err != nil {
+			t.Errorf("ParseMode(%q): unexpected error: %s", s, err)
+		}
+
+		if got != expected {
+			t.Errorf("ParseMode(%q) = %s, expected %s", s, got, expected)
+		}
+	}
+
+	if _, err := ParseMode("rewind"); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestScrub(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Body     string
+		Expected string
+	}{
+		{
+			Name:     "JSON",
+			Body:     `{"Name":"example","SecretString":"hunter2","Nested":{"Password":"p\"w"}}`,
+			Expected: `{"Name":"example","SecretString":"REDACTED","Nested":{"Password":"REDACTED"}}`,
+		},
+		{
+			Name:     "XML",
+			Body:     `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>abc/123</SecretAccessKey><SessionToken>xyz</SessionToken></Credentials>`,
+			Expected: `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>`,
+		},
+		{
+			Name:     "form",
+			Body:     `Action=CreateDBInstance&MasterUserPassword=hunter2&DBInstanceIdentifier=tf-acc-test-1`,
+			Expected: `Action=CreateDBInstance&MasterUserPassword=REDACTED&DBInstanceIdentifier=tf-acc-test-1`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := scrub(testCase.Body); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRequestMatchKey(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		A        Request
+		B        Request
+		Expected bool
+	}{
+		{
+			Name:     "form parameter order",
+			A:        Request{Method: "POST", URL: "https://sqs.us-west-2.amazonaws.com/", Body: "Action=CreateQueue&QueueName=tf-acc-test-1&Version=2012-11-05"},
+			B:        Request{Method: "POST", URL: "https://sqs.us-west-2.amazonaws.com/", Body: "Version=2012-11-05&QueueName=tf-acc-test-2&Action=CreateQueue"},
+			Expected: true,
+		},
+		{
+			Name:     "JSON key order and timestamps",
+			A:        Request{Method: "POST", URL: "https://logs.us-west-2.amazonaws.com/", Headers: map[string]string{"X-Amz-Target": "Logs_20140328.GetLogEvents"}, Body: `{"logGroupName":"a","startTime":"2022-05-01T12:00:00Z"}`},
+			B:        Request{Method: "POST", URL: "https://logs.us-west-2.amazonaws.com/", Headers: map[string]string{"X-Amz-Target": "Logs_20140328.GetLogEvents"}, Body: `{"startTime":"2022-05-02T13:00:00Z","logGroupName":"a"}`},
+			Expected: true,
+		},
+		{
+			Name:     "different operation",
+			A:        Request{Method: "POST", URL: "https://logs.us-west-2.amazonaws.com/", Headers: map[string]string{"X-Amz-Target": "Logs_20140328.CreateLogGroup"}, Body: `{"logGroupName":"a"}`},
+			B:        Request{Method: "POST", URL: "https://logs.us-west-2.amazonaws.com/", Headers: map[string]string{"X-Amz-Target": "Logs_20140328.DeleteLogGroup"}, Body: `{"logGroupName":"a"}`},
+			Expected: false,
+		},
+		{
+			Name:     "different value",
+			A:        Request{Method: "POST", URL: "https://sns.us-west-2.amazonaws.com/", Body: "Action=CreateTopic&Name=a"},
+			B:        Request{Method: "POST", URL: "https://sns.us-west-2.amazonaws.com/", Body: "Action=CreateTopic&Name=b"},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.A.matchKey() == testCase.B.matchKey(); got != testCase.Expected {
+				t.Errorf("got %t, expected %t\n%s\n%s", got, testCase.Expected, testCase.A.matchKey(), testCase.B.matchKey())
+			}
+		})
+	}
+}
+
+func TestRecorder_recordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		b, _ := io.ReadAll(r.Body)
+		name := strings.TrimPrefix(string(b), "Action=CreateQueue&QueueName=")
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = io.WriteString(w, "<QueueUrl>https://sqs/123456789012/"+name+"</QueueUrl>")
+	}))
+	defer server.Close()
+
+	filename := filepath.Join(t.TempDir(), "TestAccExample")
+
+	recorder := NewRecorder(ModeRecord, http.DefaultTransport)
+	recorder.Start(&Cassette{})
+
+	resp, err := recorder.HTTPClient().Post(server.URL+"/?X-Amz-Signature=abc", "application/x-www-form-urlencoded", strings.NewReader("Action=CreateQueue&QueueName=tf-acc-test-111"))
+	if err != nil {
+		t.Fatalf("record: %s", err)
+	}
+	resp.Body.Close()
+
+	if err := recorder.Stop().Save(filename); err != nil {
+		t.Fatalf("saving cassette: %s", err)
+	}
+
+	cassette, err := LoadCassette(filename)
+	if err != nil {
+		t.Fatalf("loading cassette: %s", err)
+	}
+
+	if n := len(cassette.Interactions); n != 1 {
+		t.Fatalf("expected 1 interaction, got %d", n)
+	}
+
+	if got := cassette.Interactions[0].Request.URL; strings.Contains(got, "X-Amz-Signature") {
+		t.Errorf("expected signature to be removed from URL: %s", got)
+	}
+
+	if _, ok := cassette.Interactions[0].Response.Headers["Set-Cookie"]; ok {
+		t.Errorf("expected Set-Cookie header to be removed")
+	}
+
+	replayer := NewRecorder(ModeReplay, nil)
+	replayer.Start(cassette)
+
+	resp, err = replayer.HTTPClient().Post(server.URL+"/?X-Amz-Signature=def", "application/x-www-form-urlencoded", strings.NewReader("Action=CreateQueue&QueueName=tf-acc-test-222"))
+	if err != nil {
+		t.Fatalf("replay: %s", err)
+	}
+	b, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if expected := "<QueueUrl>https://sqs/123456789012/tf-acc-test-222</QueueUrl>"; string(b) != expected {
+		t.Errorf("got %s, expected %s", b, expected)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected 1 call to server, got %d", calls)
+	}
+
+	if _, err := replayer.HTTPClient().Post(server.URL, "application/x-www-form-urlencoded", strings.NewReader("Action=DeleteQueue")); err == nil {
+		t.Errorf("expected error for unrecorded request")
+	}
+}
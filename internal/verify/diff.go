@@ -20,9 +20,12 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// Also returns an error if the merged tags are missing any tags, or have
+// values not allowed, by the provider-level required tags configuration.
 func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*conns.AWSClient).RequiredTagsConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -30,7 +33,16 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	mergedTags := defaultTagsConfig.MergeTags(resourceTags)
+
+	// Tags are only known during plan if they don't reference other resources' attributes.
+	if diff.NewValueKnown("tags") {
+		if err := requiredTagsConfig.Validate(mergedTags); err != nil {
+			return err
+		}
+	}
+
+	allTags := mergedTags.IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
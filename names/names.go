@@ -166,6 +166,20 @@ func EnvVar(service string) string {
 	return ""
 }
 
+// HumanFriendly returns the human friendly service name without the brand,
+// which is used as the documentation subcategory (e.g., "EKS (Elastic Kubernetes)").
+func HumanFriendly(service string) (string, error) {
+	if v, ok := serviceData[service]; ok {
+		return v.HumanFriendly, nil
+	}
+
+	if s, err := ProviderPackageForAlias(service); err == nil {
+		return HumanFriendly(s)
+	}
+
+	return "", fmt.Errorf("no service data found for %s", service)
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -281,6 +281,58 @@ func TestProviderNameUpper(t *testing.T) {
 	}
 }
 
+func TestHumanFriendly(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+			Error:    true,
+		},
+		{
+			TestName: EKS,
+			Input:    EKS,
+			Expected: "EKS (Elastic Kubernetes)",
+			Error:    false,
+		},
+		{
+			TestName: "alias",
+			Input:    "cloudwatchevidently",
+			Expected: "CloudWatch Evidently",
+			Error:    false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: "",
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := HumanFriendly(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestFullHumanFriendly(t *testing.T) {
 	testCases := []struct {
 		TestName string
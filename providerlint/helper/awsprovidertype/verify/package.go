@@ -0,0 +1,21 @@
+package verify
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	PackageName = `verify`
+
+	// PackagePath is matched as a suffix of import paths, so that analyzer
+	// testdata can provide its own package.
+	PackagePath = `internal/verify`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	return astutils.IsPackageFunc(e, info, PackagePath, funcName)
+}
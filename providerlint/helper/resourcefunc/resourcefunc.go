@@ -0,0 +1,84 @@
+// Package resourcefunc finds the function declarations implementing the
+// operations of Terraform resources.
+package resourcefunc
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+)
+
+// FuncDecls returns the package level function declarations in the files, keyed by their object.
+func FuncDecls(files []*ast.File, info *types.Info) map[types.Object]*ast.FuncDecl {
+	decls := make(map[types.Object]*ast.FuncDecl)
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Recv != nil || funcDecl.Body == nil {
+				continue
+			}
+
+			if obj := info.Defs[funcDecl.Name]; obj != nil {
+				decls[obj] = funcDecl
+			}
+		}
+	}
+
+	return decls
+}
+
+// IsResource returns if the schema.Resource is a managed resource rather than a data source.
+func IsResource(resourceInfo *schema.ResourceInfo) bool {
+	return resourceInfo.DeclaresField(schema.ResourceFieldCreate) ||
+		resourceInfo.DeclaresField(schema.ResourceFieldCreateContext) ||
+		resourceInfo.DeclaresField(schema.ResourceFieldCreateWithoutTimeout)
+}
+
+// Create returns the declaration of the resource's Create function, or nil if it is not declared in the package.
+func Create(resourceInfo *schema.ResourceInfo, decls map[types.Object]*ast.FuncDecl) *ast.FuncDecl {
+	return fieldFuncDecl(resourceInfo, decls, schema.ResourceFieldCreate, schema.ResourceFieldCreateContext, schema.ResourceFieldCreateWithoutTimeout)
+}
+
+// Read returns the declaration of the resource's Read function, or nil if it is not declared in the package.
+func Read(resourceInfo *schema.ResourceInfo, decls map[types.Object]*ast.FuncDecl) *ast.FuncDecl {
+	return fieldFuncDecl(resourceInfo, decls, schema.ResourceFieldRead, schema.ResourceFieldReadContext, schema.ResourceFieldReadWithoutTimeout)
+}
+
+// Update returns the declaration of the resource's Update function, or nil if it is not declared in the package.
+func Update(resourceInfo *schema.ResourceInfo, decls map[types.Object]*ast.FuncDecl) *ast.FuncDecl {
+	return fieldFuncDecl(resourceInfo, decls, schema.ResourceFieldUpdate, schema.ResourceFieldUpdateContext, schema.ResourceFieldUpdateWithoutTimeout)
+}
+
+// IsCallTo returns if the call expression calls the function declaration.
+func IsCallTo(callExpr *ast.CallExpr, funcDecl *ast.FuncDecl, info *types.Info) bool {
+	ident, ok := callExpr.Fun.(*ast.Ident)
+
+	if !ok || funcDecl == nil {
+		return false
+	}
+
+	return info.ObjectOf(ident) == info.Defs[funcDecl.Name]
+}
+
+func fieldFuncDecl(resourceInfo *schema.ResourceInfo, decls map[types.Object]*ast.FuncDecl, fieldNames ...string) *ast.FuncDecl {
+	for _, fieldName := range fieldNames {
+		kvExpr := resourceInfo.Fields[fieldName]
+
+		if kvExpr == nil {
+			continue
+		}
+
+		ident, ok := kvExpr.Value.(*ast.Ident)
+
+		if !ok {
+			return nil
+		}
+
+		return decls[resourceInfo.TypesInfo.ObjectOf(ident)]
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/resourcefunc"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Read functions missing not found handling
+
+The AWSR003 analyzer reports when a resource Read function calls a package
+finder function (Find...) without removing the resource from state when it is
+not found. The Read function should contain a check such as:
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ... not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	decls := resourcefunc.FuncDecls(pass.Files, pass.TypesInfo)
+
+	for _, resourceInfo := range resourceInfos {
+		if !resourcefunc.IsResource(resourceInfo) {
+			continue
+		}
+
+		readFunc := resourcefunc.Read(resourceInfo, decls)
+
+		if readFunc == nil || commentIgnorer.ShouldIgnore(analyzerName, readFunc) {
+			continue
+		}
+
+		finderCallExpr := findFinderCallExpr(pass, readFunc.Body)
+
+		if finderCallExpr == nil || hasNotFoundCheck(pass, readFunc.Body) {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, finderCallExpr) {
+			continue
+		}
+
+		pass.Reportf(finderCallExpr.Pos(), "%s: missing !d.IsNewResource() && tfresource.NotFound() check calling d.SetId(\"\")", analyzerName)
+	}
+
+	return nil, nil
+}
+
+// findFinderCallExpr returns the first call to a package function named Find...
+// Methods, such as (*regexp.Regexp).FindStringSubmatch, are not finders.
+func findFinderCallExpr(pass *analysis.Pass, body *ast.BlockStmt) *ast.CallExpr {
+	var result *ast.CallExpr
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if result != nil {
+			return false
+		}
+
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		var name string
+
+		switch fun := callExpr.Fun.(type) {
+		case *ast.Ident:
+			name = fun.Name
+		case *ast.SelectorExpr:
+			if ident, ok := fun.X.(*ast.Ident); ok {
+				if _, ok := pass.TypesInfo.Uses[ident].(*types.PkgName); ok {
+					name = fun.Sel.Name
+				}
+			}
+		}
+
+		if isFinderName(name) {
+			result = callExpr
+			return false
+		}
+
+		return true
+	})
+
+	return result
+}
+
+func isFinderName(name string) bool {
+	rest := strings.TrimPrefix(name, "Find")
+
+	return rest != name && rest != "" && unicode.IsUpper(rune(rest[0]))
+}
+
+// hasNotFoundCheck returns if the body contains an if statement conditional on
+// tfresource.NotFound() and (*schema.ResourceData).IsNewResource() that calls
+// (*schema.ResourceData).SetId("").
+func hasNotFoundCheck(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var result bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if result {
+			return false
+		}
+
+		ifStmt, ok := n.(*ast.IfStmt)
+
+		if !ok {
+			return true
+		}
+
+		var notFound, isNewResource, setID bool
+
+		ast.Inspect(ifStmt.Cond, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			if tfresource.IsFunc(callExpr.Fun, pass.TypesInfo, tfresource.FuncNameNotFound) {
+				notFound = true
+			}
+
+			if schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "IsNewResource") {
+				isNewResource = true
+			}
+
+			return true
+		})
+
+		ast.Inspect(ifStmt.Body, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok || len(callExpr.Args) != 1 {
+				return true
+			}
+
+			if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "SetId") {
+				return true
+			}
+
+			if id := astutils.ExprStringValue(callExpr.Args[0]); id != nil && *id == "" {
+				setID = true
+			}
+
+			return true
+		})
+
+		result = notFound && isNewResource && setID
+
+		return !result
+	})
+
+	return result
+}
@@ -0,0 +1,133 @@
+package a
+
+import (
+	"errors"
+	"log"
+	"regexp"
+
+	"a/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func FindThingByID(id string) (*string, error) {
+	return nil, errors.New("not found")
+}
+
+/* Passing cases */
+
+func resourcePassing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourcePassingRead,
+		Delete: resourcePassingCreate,
+	}
+}
+
+func resourcePassingCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := FindThingByID(d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Thing (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
+
+func resourcePassingNoFinder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourcePassingNoFinderRead,
+		Delete: resourcePassingCreate,
+	}
+}
+
+func resourcePassingNoFinderRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourcePassingMethod() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourcePassingMethodRead,
+		Delete: resourcePassingCreate,
+	}
+}
+
+func resourcePassingMethodRead(d *schema.ResourceData, meta interface{}) error {
+	if m := regexp.MustCompile(`^(\w+)-`).FindStringSubmatch(d.Id()); m != nil {
+		d.Set("prefix", m[1])
+	}
+
+	return nil
+}
+
+func dataSourcePassing() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePassingRead,
+	}
+}
+
+func dataSourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := FindThingByID(d.Get("id").(string))
+
+	return err
+}
+
+/* Comment ignored cases */
+
+func resourceIgnored() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourceIgnoredRead,
+		Delete: resourcePassingCreate,
+	}
+}
+
+func resourceIgnoredRead(d *schema.ResourceData, meta interface{}) error {
+	//lintignore:AWSR003
+	_, err := FindThingByID(d.Id())
+
+	return err
+}
+
+/* Failing cases */
+
+func resourceFailing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourceFailingRead,
+		Delete: resourcePassingCreate,
+	}
+}
+
+func resourceFailingRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := FindThingByID(d.Id()) // want "missing !d.IsNewResource\\(\\) && tfresource.NotFound\\(\\) check"
+
+	return err
+}
+
+func resourceFailingMissingIsNewResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourceFailingMissingIsNewResourceRead,
+		Delete: resourcePassingCreate,
+	}
+}
+
+func resourceFailingMissingIsNewResourceRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := FindThingByID(d.Id()) // want "missing !d.IsNewResource\\(\\) && tfresource.NotFound\\(\\) check"
+
+	if tfresource.NotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,139 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/resourcefunc"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Create functions that do not end by calling Read
+
+The AWSR004 analyzer reports when a resource Create function does not end by
+returning the result of calling the resource Read function (or Update function,
+which itself ends by calling Read, or another package function that ends by
+calling Read), so that Computed attributes are set in the
+Terraform state after creation.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	decls := resourcefunc.FuncDecls(pass.Files, pass.TypesInfo)
+
+	for _, resourceInfo := range resourceInfos {
+		createFunc := resourcefunc.Create(resourceInfo, decls)
+		readFunc := resourcefunc.Read(resourceInfo, decls)
+
+		if createFunc == nil || readFunc == nil || commentIgnorer.ShouldIgnore(analyzerName, createFunc) {
+			continue
+		}
+
+		updateFunc := resourcefunc.Update(resourceInfo, decls)
+		stmts := createFunc.Body.List
+
+		if len(stmts) > 0 && returnsCall(pass, stmts[len(stmts)-1], readFunc, updateFunc) {
+			continue
+		}
+
+		if endsWithRead(pass, decls, createFunc, readFunc, make(map[*ast.FuncDecl]bool)) {
+			continue
+		}
+
+		pass.Reportf(createFunc.Name.Pos(), "%s: Create function should end by returning a call to the Read function", analyzerName)
+	}
+
+	return nil, nil
+}
+
+// endsWithRead returns if the function declaration ends by returning a call to the Read function,
+// or to another package function that itself ends by returning a call to the Read function.
+func endsWithRead(pass *analysis.Pass, decls map[types.Object]*ast.FuncDecl, funcDecl, readFunc *ast.FuncDecl, visited map[*ast.FuncDecl]bool) bool {
+	if visited[funcDecl] {
+		return false
+	}
+
+	visited[funcDecl] = true
+	stmts := funcDecl.Body.List
+
+	if len(stmts) == 0 {
+		return false
+	}
+
+	if returnsCall(pass, stmts[len(stmts)-1], readFunc) {
+		return true
+	}
+
+	returnStmt, ok := stmts[len(stmts)-1].(*ast.ReturnStmt)
+
+	if !ok {
+		return false
+	}
+
+	for _, expr := range returnStmt.Results {
+		callExpr, ok := expr.(*ast.CallExpr)
+
+		if !ok {
+			continue
+		}
+
+		ident, ok := callExpr.Fun.(*ast.Ident)
+
+		if !ok {
+			continue
+		}
+
+		if calledFunc := decls[pass.TypesInfo.ObjectOf(ident)]; calledFunc != nil && endsWithRead(pass, decls, calledFunc, readFunc, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// returnsCall returns if the statement is a return of a call to any of the function declarations.
+func returnsCall(pass *analysis.Pass, stmt ast.Stmt, funcDecls ...*ast.FuncDecl) bool {
+	returnStmt, ok := stmt.(*ast.ReturnStmt)
+
+	if !ok {
+		return false
+	}
+
+	var result bool
+
+	for _, expr := range returnStmt.Results {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return !result
+			}
+
+			for _, funcDecl := range funcDecls {
+				if resourcefunc.IsCallTo(callExpr, funcDecl, pass.TypesInfo) {
+					result = true
+				}
+			}
+
+			return !result
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,136 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/* Passing cases */
+
+func resourcePassing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourcePassingRead,
+		Update: resourcePassingUpdate,
+		Delete: resourcePassingDelete,
+	}
+}
+
+func resourcePassingCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return resourcePassingRead(d, meta)
+}
+
+func resourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourcePassingUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourcePassingRead(d, meta)
+}
+
+func resourcePassingDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourcePassingUpdateCall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingUpdateCallCreate,
+		Read:   resourcePassingRead,
+		Update: resourcePassingUpdate,
+		Delete: resourcePassingDelete,
+	}
+}
+
+func resourcePassingUpdateCallCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return resourcePassingUpdate(d, meta)
+}
+
+func resourcePassingHelperCall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePassingHelperCallCreate,
+		Read:   resourcePassingRead,
+		Delete: resourcePassingDelete,
+	}
+}
+
+func resourcePassingHelperCallCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourcePassingHelperCallPut(d, meta)
+}
+
+func resourcePassingHelperCallPut(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return resourcePassingRead(d, meta)
+}
+
+/* Comment ignored cases */
+
+func resourceIgnored() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIgnoredCreate,
+		Read:   resourcePassingRead,
+		Delete: resourcePassingDelete,
+	}
+}
+
+//lintignore:AWSR004
+func resourceIgnoredCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return nil
+}
+
+/* Failing cases */
+
+func resourceFailing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFailingCreate,
+		Read:   resourcePassingRead,
+		Delete: resourcePassingDelete,
+	}
+}
+
+func resourceFailingCreate(d *schema.ResourceData, meta interface{}) error { // want "Create function should end by returning a call to the Read function"
+	d.SetId("test")
+
+	return nil
+}
+
+func resourceFailingReadNotLast() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFailingReadNotLastCreate,
+		Read:   resourcePassingRead,
+		Delete: resourcePassingDelete,
+	}
+}
+
+func resourceFailingReadNotLastCreate(d *schema.ResourceData, meta interface{}) error { // want "Create function should end by returning a call to the Read function"
+	d.SetId("test")
+
+	if err := resourcePassingRead(d, meta); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceFailingHelperCall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFailingHelperCallCreate,
+		Read:   resourcePassingRead,
+		Delete: resourcePassingDelete,
+	}
+}
+
+func resourceFailingHelperCallCreate(d *schema.ResourceData, meta interface{}) error { // want "Create function should end by returning a call to the Read function"
+	return resourceFailingHelperCallPut(d, meta)
+}
+
+func resourceFailingHelperCallPut(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of non-scalar values that ignore the returned error
+
+The AWSR005 analyzer reports when the error returned by a
+(schema.ResourceData).Set() call is ignored and the value is not a scalar,
+such as a slice, map, struct or *schema.Set. Setting these values can fail,
+e.g. when the value does not match the attribute schema, and the error should
+be returned.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourcedatasetcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	callExprs := pass.ResultOf[resourcedatasetcallexpr.Analyzer].([]*ast.CallExpr)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	// Only calls used as statements discard their result.
+	ignoredErr := make(map[*ast.CallExpr]bool)
+
+	inspect.Preorder([]ast.Node{(*ast.ExprStmt)(nil)}, func(n ast.Node) {
+		if callExpr, ok := n.(*ast.ExprStmt).X.(*ast.CallExpr); ok {
+			ignoredErr[callExpr] = true
+		}
+	})
+
+	for _, callExpr := range callExprs {
+		if !ignoredErr[callExpr] || len(callExpr.Args) < 2 {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			continue
+		}
+
+		if !isNonScalar(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			continue
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: error from d.Set() of non-scalar value should be checked", analyzerName)
+	}
+
+	return nil, nil
+}
+
+// isNonScalar returns if the type is a slice, map or struct, or a pointer to one.
+func isNonScalar(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Array, *types.Map, *types.Slice, *types.Struct:
+		return true
+	default:
+		return false
+	}
+}
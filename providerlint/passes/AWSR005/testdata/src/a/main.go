@@ -0,0 +1,43 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type thing struct {
+	Name string
+}
+
+func f() {
+	var d schema.ResourceData
+	var name *string
+	var count int64
+	var value interface{}
+
+	/* Passing cases */
+
+	d.Set("name", "test")
+	d.Set("name", name)
+	d.Set("count", count)
+	d.Set("value", value)
+
+	if err := d.Set("names", []string{"test"}); err != nil {
+		return
+	}
+
+	_ = d.Set("tags", map[string]interface{}{})
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	d.Set("names", []string{"test"})
+
+	d.Set("names", []string{"test"}) //lintignore:AWSR005
+
+	/* Failing cases */
+
+	d.Set("names", []string{"test"})                                // want "error from d.Set\\(\\) of non-scalar value should be checked"
+	d.Set("tags", map[string]interface{}{})                         // want "error from d.Set\\(\\) of non-scalar value should be checked"
+	d.Set("set", schema.NewSet(schema.HashString, []interface{}{})) // want "error from d.Set\\(\\) of non-scalar value should be checked"
+	d.Set("thing", []thing{{Name: "test"}})                         // want "error from d.Set\\(\\) of non-scalar value should be checked"
+}
@@ -0,0 +1,119 @@
+package AWSR006
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/verify"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/resourcefunc"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resources with tags missing verify.SetTagsDiff in CustomizeDiff
+
+The AWSR006 analyzer reports when a resource with a tags attribute does not
+reference verify.SetTagsDiff in its CustomizeDiff, which is required to
+calculate the tags_all attribute from the provider default tags.
+`
+
+const analyzerName = "AWSR006"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	decls := resourcefunc.FuncDecls(pass.Files, pass.TypesInfo)
+
+	for _, resourceInfo := range resourceInfos {
+		if !resourcefunc.IsResource(resourceInfo) {
+			continue
+		}
+
+		tagsKeyValueExpr := schemaKeyValueExpr(resourceInfo, "tags")
+
+		if tagsKeyValueExpr == nil || commentIgnorer.ShouldIgnore(analyzerName, tagsKeyValueExpr) {
+			continue
+		}
+
+		if kvExpr := resourceInfo.Fields[schema.ResourceFieldCustomizeDiff]; kvExpr != nil && referencesSetTagsDiff(pass, decls, kvExpr.Value, make(map[*ast.FuncDecl]bool)) {
+			continue
+		}
+
+		pass.Reportf(tagsKeyValueExpr.Pos(), "%s: resource with tags attribute should include verify.SetTagsDiff in CustomizeDiff", analyzerName)
+	}
+
+	return nil, nil
+}
+
+// schemaKeyValueExpr returns the Schema map element for the attribute, if the map is declared inline.
+func schemaKeyValueExpr(resourceInfo *schema.ResourceInfo, attributeName string) *ast.KeyValueExpr {
+	kvExpr := resourceInfo.Fields[schema.ResourceFieldSchema]
+
+	if kvExpr == nil {
+		return nil
+	}
+
+	compositeLit, ok := kvExpr.Value.(*ast.CompositeLit)
+
+	if !ok {
+		return nil
+	}
+
+	for _, elt := range compositeLit.Elts {
+		kvExpr, ok := elt.(*ast.KeyValueExpr)
+
+		if !ok {
+			continue
+		}
+
+		if key := astutils.ExprStringValue(kvExpr.Key); key != nil && *key == attributeName {
+			return kvExpr
+		}
+	}
+
+	return nil
+}
+
+// referencesSetTagsDiff returns if the expression contains verify.SetTagsDiff, e.g. within customdiff.Sequence()
+// or within the body of a function declared in the package.
+func referencesSetTagsDiff(pass *analysis.Pass, decls map[types.Object]*ast.FuncDecl, node ast.Node, visited map[*ast.FuncDecl]bool) bool {
+	var result bool
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if result {
+			return false
+		}
+
+		expr, ok := n.(ast.Expr)
+
+		if !ok {
+			return true
+		}
+
+		if verify.IsFunc(expr, pass.TypesInfo, verify.FuncNameSetTagsDiff) {
+			result = true
+		} else if ident, ok := expr.(*ast.Ident); ok {
+			if funcDecl := decls[pass.TypesInfo.ObjectOf(ident)]; funcDecl != nil && !visited[funcDecl] {
+				visited[funcDecl] = true
+				result = referencesSetTagsDiff(pass, decls, funcDecl.Body, visited)
+			}
+		}
+
+		return !result
+	})
+
+	return result
+}
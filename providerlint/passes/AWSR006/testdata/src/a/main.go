@@ -0,0 +1,179 @@
+package a
+
+import (
+	"context"
+
+	"a/internal/verify"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// sequence is like customdiff.Sequence.
+func sequence(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+/* Passing cases */
+
+func resourcePassing() *schema.Resource {
+	return &schema.Resource{
+		Create:        f,
+		Read:          f,
+		Delete:        f,
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourcePassingSequence() *schema.Resource {
+	return &schema.Resource{
+		Create: f,
+		Read:   f,
+		Delete: f,
+		CustomizeDiff: sequence(
+			verify.SetTagsDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourcePassingSequenceFunc() *schema.Resource {
+	return &schema.Resource{
+		Create: f,
+		Read:   f,
+		Delete: f,
+		CustomizeDiff: sequence(
+			verify.SetTagsDiff,
+			customizeDiffFailing,
+		),
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourcePassingFunc() *schema.Resource {
+	return &schema.Resource{
+		Create:        f,
+		Read:          f,
+		Delete:        f,
+		CustomizeDiff: customizeDiffPassing,
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func customizeDiffPassing(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("name").(string) == "default" {
+		return nil
+	}
+
+	return verify.SetTagsDiff(ctx, d, meta)
+}
+
+func resourcePassingNoTags() *schema.Resource {
+	return &schema.Resource{
+		Create: f,
+		Read:   f,
+		Delete: f,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourcePassing() *schema.Resource {
+	return &schema.Resource{
+		Read: f,
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+/* Comment ignored cases */
+
+func resourceIgnored() *schema.Resource {
+	return &schema.Resource{
+		Create: f,
+		Read:   f,
+		Delete: f,
+		Schema: map[string]*schema.Schema{
+			//lintignore:AWSR006
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+/* Failing cases */
+
+func resourceFailing() *schema.Resource {
+	return &schema.Resource{
+		Create: f,
+		Read:   f,
+		Delete: f,
+		Schema: map[string]*schema.Schema{
+			"tags": { // want "resource with tags attribute should include verify.SetTagsDiff in CustomizeDiff"
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceFailingFunc() *schema.Resource {
+	return &schema.Resource{
+		Create:        f,
+		Read:          f,
+		Delete:        f,
+		CustomizeDiff: customizeDiffFailing,
+		Schema: map[string]*schema.Schema{
+			"tags": { // want "resource with tags attribute should include verify.SetTagsDiff in CustomizeDiff"
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func customizeDiffFailing(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return nil
+}
@@ -9,6 +9,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSAT006"
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR001"
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR002"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR003"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR004"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR005"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSR006"
 	"github.com/hashicorp/terraform-provider-aws/providerlint/passes/AWSV001"
 	"golang.org/x/tools/go/analysis"
 )
@@ -22,5 +26,9 @@ var AllChecks = []*analysis.Analyzer{
 	AWSAT006.Analyzer,
 	AWSR001.Analyzer,
 	AWSR002.Analyzer,
+	AWSR003.Analyzer,
+	AWSR004.Analyzer,
+	AWSR005.Analyzer,
+	AWSR006.Analyzer,
 	AWSV001.Analyzer,
 }
@@ -10,13 +10,14 @@ var (
 	clearComments bool
 	name          string
 	force         bool
+	withWaiters   bool
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force)
+		return resource.Create(name, snakeName, !clearComments, force, withWaiters)
 	},
 }
 
@@ -26,4 +27,5 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "Do not include instructional comments in source")
 	resourceCmd.Flags().StringVarP(&name, "name", "n", "", "Name of the entity")
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "Force creation, overwriting existing files")
+	resourceCmd.Flags().BoolVarP(&withWaiters, "with-waiters", "w", false, "Add finder, status and waiter functions to find.go, status.go and wait.go")
 }
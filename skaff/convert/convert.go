@@ -0,0 +1,20 @@
+package convert
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ToSnakeCase converts a properly capitalized name (e.g., DBInstance) to snake
+// case (e.g., db_instance), unless an explicit snake case name is given.
+func ToSnakeCase(upper string, snakeName string) string {
+	if snakeName != "" {
+		return snakeName
+	}
+
+	re := regexp.MustCompile(`([a-z])([A-Z]{2,})`)
+	upper = re.ReplaceAllString(upper, `${1}_${2}`)
+
+	re2 := regexp.MustCompile(`([A-Z][a-z])`)
+	return strings.TrimPrefix(strings.ToLower(re2.ReplaceAllString(upper, `_$1`)), "_")
+}
@@ -1,4 +1,4 @@
-package resource
+package convert
 
 import (
 	"testing"
@@ -44,7 +44,7 @@ func TestToSnakeName(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.TestName, func(t *testing.T) {
-			got := toSnakeCase(testCase.Input, "")
+			got := ToSnakeCase(testCase.Input, "")
 
 			if got != testCase.Expected {
 				t.Errorf("got %s, expected %s", got, testCase.Expected)
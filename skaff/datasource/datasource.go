@@ -0,0 +1,150 @@
+package datasource
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed datasource.tmpl
+var datasourceTmpl string
+
+//go:embed datasourcetest.tmpl
+var datasourceTestTmpl string
+
+//go:embed websitedoc.tmpl
+var websiteTmpl string
+
+type TemplateData struct {
+	DataSource        string
+	DataSourceSnake   string
+	IncludeComments   bool
+	Plural            bool
+	ServicePackage    string
+	Service           string
+	ServiceLower      string
+	AWSServiceName    string
+	HumanFriendly     string
+	AWSGoV1Package    string
+	AWSGoV1ClientName string
+}
+
+func Create(dsName, snakeName string, comments, force, plural bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if dsName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if dsName == strings.ToLower(dsName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	sn, err := names.FullHumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service name: %w", err)
+	}
+
+	hf, err := names.HumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service documentation subcategory: %w", err)
+	}
+
+	goV1Package, err := names.AWSGoV1Package(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS Go SDK v1 package: %w", err)
+	}
+
+	goV1ClientName, err := names.AWSGoV1ClientName(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS Go SDK v1 client name: %w", err)
+	}
+
+	templateData := TemplateData{
+		DataSource:        dsName,
+		DataSourceSnake:   convert.ToSnakeCase(dsName, snakeName),
+		IncludeComments:   comments,
+		Plural:            plural,
+		ServicePackage:    servicePackage,
+		Service:           s,
+		ServiceLower:      strings.ToLower(s),
+		AWSServiceName:    sn,
+		HumanFriendly:     hf,
+		AWSGoV1Package:    goV1Package,
+		AWSGoV1ClientName: goV1ClientName,
+	}
+
+	f := fmt.Sprintf("%s_data_source.go", templateData.DataSourceSnake)
+	if err = writeTemplate("newds", f, datasourceTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing data source template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_data_source_test.go", templateData.DataSourceSnake)
+	if err = writeTemplate("dstest", tf, datasourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing data source test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, templateData.DataSourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing data source website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !os.IsNotExist(err) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		f.Close() // ignore error; parse error takes precedence
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		f.Close() // ignore error; execute error takes precedence
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if _, err := f.Write(buffer.Bytes()); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
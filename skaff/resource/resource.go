@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
 )
 
 //go:embed resource.tmpl
@@ -22,29 +22,31 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
-type TemplateData struct {
-	Resource        string
-	ResourceLower   string
-	IncludeComments bool
-	ServicePackage  string
-	Service         string
-	ServiceLower    string
-	AWSServiceName  string
-}
+//go:embed find.tmpl
+var findTmpl string
 
-func toSnakeCase(upper string, snakeName string) string {
-	if snakeName != "" {
-		return snakeName
-	}
+//go:embed status.tmpl
+var statusTmpl string
 
-	re := regexp.MustCompile(`([a-z])([A-Z]{2,})`)
-	upper = re.ReplaceAllString(upper, `${1}_${2}`)
+//go:embed wait.tmpl
+var waitTmpl string
 
-	re2 := regexp.MustCompile(`([A-Z][a-z])`)
-	return strings.TrimPrefix(strings.ToLower(re2.ReplaceAllString(upper, `_$1`)), "_")
+type TemplateData struct {
+	Resource          string
+	ResourceLower     string
+	IncludeComments   bool
+	WithWaiters       bool
+	Append            bool // Whether the template is appended to an existing file
+	ServicePackage    string
+	Service           string
+	ServiceLower      string
+	AWSServiceName    string
+	HumanFriendly     string
+	AWSGoV1Package    string
+	AWSGoV1ClientName string
 }
 
-func Create(resName, snakeName string, comments, force bool) error {
+func Create(resName, snakeName string, comments, force, withWaiters bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -74,32 +76,69 @@ func Create(resName, snakeName string, comments, force bool) error {
 		return fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
-	templateData := TemplateData{
-		Resource:        resName,
-		ResourceLower:   strings.ToLower(resName),
-		IncludeComments: comments,
-		ServicePackage:  servicePackage,
-		Service:         s,
-		ServiceLower:    strings.ToLower(s),
-		AWSServiceName:  sn,
+	hf, err := names.HumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service documentation subcategory: %w", err)
 	}
 
-	f := fmt.Sprintf("%s.go", toSnakeCase(resName, snakeName))
+	goV1Package, err := names.AWSGoV1Package(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS Go SDK v1 package: %w", err)
+	}
+
+	goV1ClientName, err := names.AWSGoV1ClientName(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS Go SDK v1 client name: %w", err)
+	}
+
+	templateData := TemplateData{
+		Resource:          resName,
+		ResourceLower:     strings.ToLower(resName),
+		IncludeComments:   comments,
+		WithWaiters:       withWaiters,
+		ServicePackage:    servicePackage,
+		Service:           s,
+		ServiceLower:      strings.ToLower(s),
+		AWSServiceName:    sn,
+		HumanFriendly:     hf,
+		AWSGoV1Package:    goV1Package,
+		AWSGoV1ClientName: goV1ClientName,
+	}
+
+	f := fmt.Sprintf("%s.go", convert.ToSnakeCase(resName, snakeName))
 	if err = writeTemplate("newres", f, resourceTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
 	}
 
-	tf := fmt.Sprintf("%s_test.go", toSnakeCase(resName, snakeName))
+	tf := fmt.Sprintf("%s_test.go", convert.ToSnakeCase(resName, snakeName))
 	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource test template: %w", err)
 	}
 
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, toSnakeCase(resName, snakeName))
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, convert.ToSnakeCase(resName, snakeName))
 	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
 	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
+	if !withWaiters {
+		return nil
+	}
+
+	// Services typically already have finders, status and waiter functions so
+	// these are added to the existing files.
+	if err = appendTemplate("find", "find.go", findTmpl, templateData); err != nil {
+		return fmt.Errorf("writing finder template: %w", err)
+	}
+
+	if err = appendTemplate("status", "status.go", statusTmpl, templateData); err != nil {
+		return fmt.Errorf("writing status template: %w", err)
+	}
+
+	if err = appendTemplate("wait", "wait.go", waitTmpl, templateData); err != nil {
+		return fmt.Errorf("writing waiter template: %w", err)
+	}
+
 	return nil
 }
 
@@ -108,19 +147,39 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
 
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	return executeTemplate(templateName, filename, tmpl, os.O_RDWR|os.O_CREATE|os.O_TRUNC, td)
+}
+
+// appendTemplate appends to the file if it exists, leaving out the package
+// clause and imports, or otherwise creates it.
+func appendTemplate(templateName, filename, tmpl string, td TemplateData) error {
+	_, err := os.Stat(filename)
+
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error checking file (%s): %s", filename, err)
+	}
+
+	td.Append = err == nil
+
+	return executeTemplate(templateName, filename, tmpl, os.O_WRONLY|os.O_CREATE|os.O_APPEND, td)
+}
+
+func executeTemplate(templateName, filename, tmpl string, flag int, td TemplateData) error {
+	f, err := os.OpenFile(filename, flag, 0644)
 	if err != nil {
 		return fmt.Errorf("error opening file (%s): %s", filename, err)
 	}
 
 	tplate, err := template.New(templateName).Parse(tmpl)
 	if err != nil {
+		f.Close() // ignore error; parse error takes precedence
 		return fmt.Errorf("error parsing template: %s", err)
 	}
 
 	var buffer bytes.Buffer
 	err = tplate.Execute(&buffer, td)
 	if err != nil {
+		f.Close() // ignore error; execute error takes precedence
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
@@ -0,0 +1,140 @@
+package sweeper
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+const sweepFilename = "sweep.go"
+
+type TemplateData struct {
+	Resource          string
+	ResourceSnake     string
+	IncludeComments   bool
+	Append            bool // Whether the sweeper is added to an existing sweep.go
+	ServicePackage    string
+	Service           string
+	AWSGoV1Package    string
+	AWSGoV1ClientName string
+}
+
+// Create adds a sweeper for the resource to the service's sweep.go, creating
+// the file if it does not exist.
+func Create(resName, snakeName string, comments bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	goV1Package, err := names.AWSGoV1Package(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS Go SDK v1 package: %w", err)
+	}
+
+	goV1ClientName, err := names.AWSGoV1ClientName(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS Go SDK v1 client name: %w", err)
+	}
+
+	templateData := TemplateData{
+		Resource:          resName,
+		ResourceSnake:     convert.ToSnakeCase(resName, snakeName),
+		IncludeComments:   comments,
+		ServicePackage:    servicePackage,
+		Service:           s,
+		AWSGoV1Package:    goV1Package,
+		AWSGoV1ClientName: goV1ClientName,
+	}
+
+	existing, err := os.ReadFile(sweepFilename)
+
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error reading file (%s): %s", sweepFilename, err)
+	}
+
+	templateData.Append = err == nil
+
+	tplate, err := template.New("sweep").Parse(sweepTmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, templateData); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents := buffer.Bytes()
+
+	if templateData.Append {
+		var registration bytes.Buffer
+		if err := tplate.ExecuteTemplate(&registration, "register", templateData); err != nil {
+			return fmt.Errorf("error executing template: %s", err)
+		}
+
+		name := fmt.Sprintf("aws_%s_%s", servicePackage, templateData.ResourceSnake)
+
+		if contents, err = addSweeper(existing, registration.Bytes(), contents, name); err != nil {
+			return fmt.Errorf("error adding sweeper to file (%s): %w", sweepFilename, err)
+		}
+	}
+
+	if err := os.WriteFile(sweepFilename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", sweepFilename, err)
+	}
+
+	return nil
+}
+
+// addSweeper inserts the sweeper registration at the end of the init function
+// of an existing sweep.go and appends the sweeper function.
+func addSweeper(existing, registration, sweeper []byte, name string) ([]byte, error) {
+	src := string(existing)
+
+	start := strings.Index(src, "\nfunc init() {\n")
+	if start == -1 {
+		return nil, fmt.Errorf("init function not found")
+	}
+
+	end := strings.Index(src[start:], "\n}\n")
+	if end == -1 {
+		return nil, fmt.Errorf("end of init function not found")
+	}
+	end += start
+
+	if strings.Contains(src, fmt.Sprintf("%q", name)) {
+		return nil, fmt.Errorf("sweeper (%s) already registered", name)
+	}
+
+	return []byte(src[:end] + "\n" + string(registration) + src[end:] + string(sweeper)), nil
+}
@@ -0,0 +1,71 @@
+package sweeper
+
+import (
+	"testing"
+)
+
+func TestAddSweeper(t *testing.T) {
+	existing := `package test
+
+func init() {
+	sweep.AddTestSweepers("aws_test_one", &resource.Sweeper{
+		Name: "aws_test_one",
+		F:    sweepOnes,
+	})
+}
+
+func sweepOnes(region string) error {
+	return nil
+}
+`
+	registration := `
+	sweep.AddTestSweepers("aws_test_two", &resource.Sweeper{
+		Name: "aws_test_two",
+		F:    sweepTwos,
+	})`
+	sweeper := `
+func sweepTwos(region string) error {
+	return nil
+}
+`
+	expected := `package test
+
+func init() {
+	sweep.AddTestSweepers("aws_test_one", &resource.Sweeper{
+		Name: "aws_test_one",
+		F:    sweepOnes,
+	})
+
+	sweep.AddTestSweepers("aws_test_two", &resource.Sweeper{
+		Name: "aws_test_two",
+		F:    sweepTwos,
+	})
+}
+
+func sweepOnes(region string) error {
+	return nil
+}
+
+func sweepTwos(region string) error {
+	return nil
+}
+`
+
+	got, err := addSweeper([]byte(existing), []byte(registration), []byte(sweeper), "aws_test_two")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(got) != expected {
+		t.Errorf("got:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	if _, err := addSweeper([]byte(existing), []byte(registration), []byte(sweeper), "aws_test_one"); err == nil {
+		t.Errorf("expected error adding registered sweeper")
+	}
+
+	if _, err := addSweeper([]byte("package test\n"), []byte(registration), []byte(sweeper), "aws_test_two"); err == nil {
+		t.Errorf("expected error without init function")
+	}
+}